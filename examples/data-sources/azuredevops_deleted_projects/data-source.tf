@@ -0,0 +1,2 @@
+data "azuredevops_deleted_projects" "all" {
+}
@@ -0,0 +1,7 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+data "azuredevops_features" "sandbox" {
+  project_id = data.azuredevops_project.sandbox.id
+}
@@ -0,0 +1,8 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+data "azuredevops_project_properties" "sandbox" {
+  keys       = ["CostCenter", "Owner"]
+  project_id = data.azuredevops_project.sandbox.id
+}
@@ -0,0 +1,9 @@
+data "azuredevops_projects" "teams" {
+  include_capabilities = true
+  name_prefix          = "team-"
+}
+
+data "azuredevops_projects" "public" {
+  state      = "all"
+  visibility = "public"
+}
@@ -0,0 +1,2 @@
+# Agent pools can be imported using their ID
+terraform import azuredevops_agent_pool.example 12
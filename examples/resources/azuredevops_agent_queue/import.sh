@@ -0,0 +1,2 @@
+# Agent queues can be imported using the project ID or name and the queue ID
+terraform import azuredevops_agent_queue.example Sandbox/34
@@ -0,0 +1,2 @@
+# Areas can be imported using the project ID or name and the path of the area
+terraform import azuredevops_area.example "Sandbox/Team A/Backend"
@@ -0,0 +1,3 @@
+# Area permissions can be imported using the project ID or name, the principal name and the path of the area.
+# Omit the path to import the permissions on the root area.
+terraform import azuredevops_area_permissions.example "Sandbox/[Sandbox]\\Contributors/Team A"
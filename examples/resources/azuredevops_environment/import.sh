@@ -0,0 +1,2 @@
+# Environments can be imported using the project ID or name and the environment ID
+terraform import azuredevops_environment.example Sandbox/5
@@ -0,0 +1,2 @@
+# Kubernetes resources can be imported using the project ID or name, the environment ID and the resource ID
+terraform import azuredevops_environment_kubernetes.example Sandbox/5/2
@@ -0,0 +1,3 @@
+# Environment permissions can be imported using the project ID or name, the principal name and the environment ID.
+# Omit the environment ID to import the permissions on all environments of the project.
+terraform import azuredevops_environment_permissions.example "Sandbox/[Sandbox]\\Contributors/5"
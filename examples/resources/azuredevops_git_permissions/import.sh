@@ -0,0 +1,3 @@
+# Git permissions can be imported using the project ID or name, the principal name and the repository ID.
+# Omit the repository ID to import the permissions on all repositories of the project.
+terraform import azuredevops_git_permissions.example "Sandbox/[Sandbox]\\Contributors/11111111-1111-1111-1111-111111111111"
@@ -0,0 +1,3 @@
+# Groups can be imported using their descriptor, or the project ID or name and the display name of the group
+terraform import azuredevops_group.example vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5LTI0MDI5ODY0MTMtMjE3OTQwODYxNi0zLTI3NzQ3NzY1NDgtMTk5NDc1NTI0Ni0yNjA2NjI0MjQ4LTk4NjY0ODk2MQ
+terraform import azuredevops_group.example "Sandbox/Release Managers"
@@ -0,0 +1,2 @@
+# Group memberships can be imported using the project ID or name and the display name of the group
+terraform import azuredevops_group_membership.example "Sandbox/Release Managers"
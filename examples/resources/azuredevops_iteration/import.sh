@@ -0,0 +1,2 @@
+# Iterations can be imported using the project ID or name and the path of the iteration
+terraform import azuredevops_iteration.example "Sandbox/Release 1/Sprint 1"
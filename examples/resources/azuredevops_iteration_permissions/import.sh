@@ -0,0 +1,3 @@
+# Iteration permissions can be imported using the project ID or name, the principal name and the path of the iteration.
+# Omit the path to import the permissions on the root iteration.
+terraform import azuredevops_iteration_permissions.example "Sandbox/[Sandbox]\\Contributors/Release 1"
@@ -0,0 +1,3 @@
+# Pipeline permissions can be imported using the project ID or name, the principal name and the pipeline ID.
+# Omit the pipeline ID to import the permissions on all pipelines of the project.
+terraform import azuredevops_pipeline_permissions.example "Sandbox/[Sandbox]\\Contributors/7"
@@ -0,0 +1,2 @@
+# Pipeline settings can be imported using the project ID or name
+terraform import azuredevops_pipeline_settings.example Sandbox
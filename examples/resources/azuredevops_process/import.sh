@@ -0,0 +1,2 @@
+# Inherited processes can be imported using the ID or the name of the process
+terraform import azuredevops_process.example "Custom Agile"
@@ -0,0 +1,11 @@
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_process" "custom_agile" {
+  description       = "The Agile process of the organization."
+  is_default        = true
+  name              = "Custom Agile"
+  parent_process_id = data.azuredevops_process.agile.id
+  reference_name    = "CustomAgile"
+}
@@ -0,0 +1,2 @@
+# Controls can be imported using the ID or the name of the process, the reference name of the work item type, the ID of the group and the reference name of the field
+terraform import azuredevops_process_control.example "Custom Agile/CustomAgile.Risk/00000000-0000-0000-0000-000000000000/Custom.RiskSeverity"
@@ -0,0 +1,7 @@
+resource "azuredevops_process_control" "severity" {
+  control_id     = azuredevops_process_work_item_type_field.severity.field
+  group_id       = azuredevops_process_group.impact.id
+  label          = "Severity"
+  process_id     = azuredevops_process.custom_agile.id
+  work_item_type = azuredevops_process_work_item_type.risk.reference_name
+}
@@ -0,0 +1,2 @@
+# Fields can be imported using their reference name
+terraform import azuredevops_process_field.example Custom.RiskSeverity
@@ -0,0 +1,12 @@
+resource "azuredevops_process_picklist" "severity" {
+  items = ["1 - Critical", "2 - High", "3 - Medium", "4 - Low"]
+  name  = "Severity"
+}
+
+resource "azuredevops_process_field" "severity" {
+  description    = "The severity of the risk."
+  name           = "Risk Severity"
+  picklist_id    = azuredevops_process_picklist.severity.id
+  reference_name = "Custom.RiskSeverity"
+  type           = "string"
+}
@@ -0,0 +1,2 @@
+# Groups can be imported using the ID or the name of the process, the reference name of the work item type and the ID of the group
+terraform import azuredevops_process_group.example "Custom Agile/CustomAgile.Risk/00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,7 @@
+resource "azuredevops_process_group" "impact" {
+  label          = "Impact"
+  page_id        = azuredevops_process_page.assessment.id
+  process_id     = azuredevops_process.custom_agile.id
+  section_id     = "Section1"
+  work_item_type = azuredevops_process_work_item_type.risk.reference_name
+}
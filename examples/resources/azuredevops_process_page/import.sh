@@ -0,0 +1,2 @@
+# Pages can be imported using the ID or the name of the process, the reference name of the work item type and the ID of the page
+terraform import azuredevops_process_page.example "Custom Agile/CustomAgile.Risk/00000000-0000-0000-0000-000000000000"
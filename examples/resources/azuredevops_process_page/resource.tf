@@ -0,0 +1,5 @@
+resource "azuredevops_process_page" "assessment" {
+  label          = "Assessment"
+  process_id     = azuredevops_process.custom_agile.id
+  work_item_type = azuredevops_process_work_item_type.risk.reference_name
+}
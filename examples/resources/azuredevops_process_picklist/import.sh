@@ -0,0 +1,2 @@
+# Picklists can be imported using their ID
+terraform import azuredevops_process_picklist.example 00000000-0000-0000-0000-000000000000
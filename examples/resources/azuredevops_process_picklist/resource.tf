@@ -0,0 +1,4 @@
+resource "azuredevops_process_picklist" "severity" {
+  items = ["1 - Critical", "2 - High", "3 - Medium", "4 - Low"]
+  name  = "Severity"
+}
@@ -0,0 +1,2 @@
+# Rules can be imported using the ID or the name of the process, the reference name of the work item type and the ID of the rule
+terraform import azuredevops_process_rule.example "Custom Agile/CustomAgile.Risk/00000000-0000-0000-0000-000000000000"
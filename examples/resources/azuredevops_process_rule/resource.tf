@@ -0,0 +1,20 @@
+resource "azuredevops_process_rule" "severity_when_active" {
+  name           = "Require the severity of the active risks"
+  process_id     = azuredevops_process.custom_agile.id
+  work_item_type = azuredevops_process_work_item_type.risk.reference_name
+
+  actions = [
+    {
+      action_type  = "makeRequired"
+      target_field = azuredevops_process_field.severity.reference_name
+    }
+  ]
+
+  conditions = [
+    {
+      condition_type = "when"
+      field          = "System.State"
+      value          = "Active"
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+# States can be imported using the ID or the name of the process, the reference name of the work item type and the ID or the name of the state
+terraform import azuredevops_process_state.example "Custom Agile/CustomAgile.Bug/Triaged"
@@ -0,0 +1,7 @@
+resource "azuredevops_process_state" "triaged" {
+  color          = "007acc"
+  name           = "Triaged"
+  process_id     = azuredevops_process.custom_agile.id
+  state_category = "InProgress"
+  work_item_type = azuredevops_process_work_item_type.bug.reference_name
+}
@@ -0,0 +1,2 @@
+# Work item types can be imported using the ID or the name of the process and the reference name of the work item type
+terraform import azuredevops_process_work_item_type.example "Custom Agile/CustomAgile.Risk"
@@ -0,0 +1,22 @@
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_process" "custom_agile" {
+  name              = "Custom Agile"
+  parent_process_id = data.azuredevops_process.agile.id
+}
+
+resource "azuredevops_process_work_item_type" "bug" {
+  color                 = "cc293d"
+  parent_work_item_type = "Microsoft.VSTS.WorkItemTypes.Bug"
+  process_id            = azuredevops_process.custom_agile.id
+}
+
+resource "azuredevops_process_work_item_type" "risk" {
+  color       = "ff9d00"
+  description = "Tracks a risk of a project."
+  icon        = "icon_insect"
+  name        = "Risk"
+  process_id  = azuredevops_process.custom_agile.id
+}
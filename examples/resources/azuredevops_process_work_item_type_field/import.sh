@@ -0,0 +1,2 @@
+# Fields of work item types can be imported using the ID or the name of the process, the reference name of the work item type and the reference name of the field
+terraform import azuredevops_process_work_item_type_field.example "Custom Agile/CustomAgile.Risk/Custom.RiskSeverity"
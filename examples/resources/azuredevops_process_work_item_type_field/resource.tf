@@ -0,0 +1,14 @@
+resource "azuredevops_process_work_item_type_field" "severity" {
+  default_value  = "3 - Medium"
+  field          = azuredevops_process_field.severity.reference_name
+  process_id     = azuredevops_process.custom_agile.id
+  required       = true
+  work_item_type = azuredevops_process_work_item_type.risk.reference_name
+}
+
+resource "azuredevops_process_work_item_type_field" "bug_priority" {
+  field          = "Microsoft.VSTS.Common.Priority"
+  process_id     = azuredevops_process.custom_agile.id
+  required       = true
+  work_item_type = azuredevops_process_work_item_type.bug.reference_name
+}
@@ -0,0 +1,2 @@
+# Projects can be imported using their ID or name
+terraform import azuredevops_project.example Sandbox
@@ -0,0 +1,2 @@
+# Project features can be imported using the project ID or name
+terraform import azuredevops_project_features.example Sandbox
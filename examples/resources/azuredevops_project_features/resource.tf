@@ -9,4 +9,8 @@ resource "azuredevops_project_features" "sandbox" {
   project_id   = data.azuredevops_project.sandbox.id
   repositories = "enabled"
   testplans    = "disabled"
+
+  features = {
+    "ms.vss-work-web.new-boards-hub-feature" = "enabled"
+  }
 }
@@ -0,0 +1,2 @@
+# Project permissions can be imported using the project ID or name and the principal name
+terraform import azuredevops_project_permissions.example "Sandbox/[Sandbox]\\Contributors"
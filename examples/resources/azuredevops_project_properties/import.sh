@@ -0,0 +1,2 @@
+# Project properties can be imported using the project ID or name, all the custom properties of the project are then managed
+terraform import azuredevops_project_properties.example Sandbox
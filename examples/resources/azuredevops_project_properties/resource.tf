@@ -0,0 +1,12 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+resource "azuredevops_project_properties" "sandbox" {
+  authoritative = true
+  project_id    = data.azuredevops_project.sandbox.id
+  properties = {
+    "CostCenter" = "CC-1234"
+    "Owner"      = "platform-team@contoso.com"
+  }
+}
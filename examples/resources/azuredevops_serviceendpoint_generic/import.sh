@@ -0,0 +1,3 @@
+# Service endpoints can be imported using the project ID or name and the service endpoint ID.
+# Secrets are not returned by Azure DevOps and are set on the next apply.
+terraform import azuredevops_serviceendpoint_generic.example Sandbox/11111111-1111-1111-1111-111111111111
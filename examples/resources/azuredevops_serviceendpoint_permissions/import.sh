@@ -0,0 +1,3 @@
+# Service endpoint permissions can be imported using the project ID or name, the principal name and the service endpoint ID.
+# Omit the service endpoint ID to import the permissions on all service endpoints of the project.
+terraform import azuredevops_serviceendpoint_permissions.example "Sandbox/[Sandbox]\\Contributors/11111111-1111-1111-1111-111111111111"
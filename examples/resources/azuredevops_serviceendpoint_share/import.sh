@@ -0,0 +1,2 @@
+# Service endpoint shares can be imported using the ID or name of the project hosting the service endpoint and the service endpoint ID
+terraform import azuredevops_serviceendpoint_share.example Sandbox/11111111-1111-1111-1111-111111111111
@@ -0,0 +1,2 @@
+# Teams can be imported using the project ID or name and the team ID or name
+terraform import azuredevops_team.example "Sandbox/Team A"
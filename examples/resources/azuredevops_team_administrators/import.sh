@@ -0,0 +1,2 @@
+# Team administrators can be imported using the project ID or name and the team ID or name, all the administrators are then read
+terraform import azuredevops_team_administrators.example "Sandbox/Team A"
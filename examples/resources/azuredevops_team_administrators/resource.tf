@@ -0,0 +1,16 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+resource "azuredevops_team" "developers" {
+  name       = "Developers Team"
+  project_id = data.azuredevops_project.sandbox.id
+}
+
+resource "azuredevops_team_administrators" "developers" {
+  project_id = data.azuredevops_project.sandbox.id
+  team_id    = azuredevops_team.developers.id
+  administrators = [
+    "someone@noreply.com", // AAD user or group based on its mail address
+  ]
+}
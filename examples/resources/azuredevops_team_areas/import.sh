@@ -0,0 +1,2 @@
+# Team areas can be imported using the project ID or name and the team ID or name
+terraform import azuredevops_team_areas.example "Sandbox/Team A"
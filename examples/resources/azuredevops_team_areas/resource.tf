@@ -0,0 +1,27 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+resource "azuredevops_team" "developers" {
+  name       = "Developers Team"
+  project_id = data.azuredevops_project.sandbox.id
+}
+
+resource "azuredevops_area" "developers" {
+  name        = "Developers"
+  parent_path = ""
+  project_id  = data.azuredevops_project.sandbox.id
+}
+
+resource "azuredevops_team_areas" "developers" {
+  default_area = azuredevops_area.developers.path
+  project_id   = data.azuredevops_project.sandbox.id
+  team_id      = azuredevops_team.developers.id
+
+  areas = [
+    {
+      include_children = true
+      path             = azuredevops_area.developers.path
+    }
+  ]
+}
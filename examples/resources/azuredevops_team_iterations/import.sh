@@ -0,0 +1,2 @@
+# Team iterations can be imported using the project ID or name and the team ID or name, all the iterations of the team are then managed
+terraform import azuredevops_team_iterations.example "Sandbox/Team A"
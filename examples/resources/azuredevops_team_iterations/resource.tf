@@ -0,0 +1,20 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+resource "azuredevops_team" "developers" {
+  name       = "Developers Team"
+  project_id = data.azuredevops_project.sandbox.id
+}
+
+resource "azuredevops_iteration" "sprint-1" {
+  name        = "Sprint 1"
+  parent_path = ""
+  project_id  = data.azuredevops_project.sandbox.id
+}
+
+resource "azuredevops_team_iterations" "developers" {
+  iterations = [azuredevops_iteration.sprint-1.path]
+  project_id = data.azuredevops_project.sandbox.id
+  team_id    = azuredevops_team.developers.id
+}
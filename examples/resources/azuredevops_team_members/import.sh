@@ -0,0 +1,2 @@
+# Team members can be imported using the project ID or name and the team ID or name, all the members are then read
+terraform import azuredevops_team_members.example "Sandbox/Team A"
@@ -0,0 +1,18 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+resource "azuredevops_team" "developers" {
+  name       = "Developers Team"
+  project_id = data.azuredevops_project.sandbox.id
+}
+
+resource "azuredevops_team_members" "developers" {
+  authoritative = true // Remove the members which are not declared below
+  project_id    = data.azuredevops_project.sandbox.id
+  team_id       = azuredevops_team.developers.id
+  members = [
+    "someone@noreply.com", // AAD user or group based on its mail address
+    "[Sandbox]\\Readers"   // Azure DevOps Group
+  ]
+}
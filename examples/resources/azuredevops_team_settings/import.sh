@@ -0,0 +1,2 @@
+# Team settings can be imported using the project ID or name and the team ID or name, all the settings are then managed
+terraform import azuredevops_team_settings.example "Sandbox/Team A"
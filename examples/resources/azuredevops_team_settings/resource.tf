@@ -0,0 +1,18 @@
+data "azuredevops_project" "sandbox" {
+  name = "Sandbox"
+}
+
+resource "azuredevops_team" "developers" {
+  name       = "Developers Team"
+  project_id = data.azuredevops_project.sandbox.id
+}
+
+resource "azuredevops_team_settings" "developers" {
+  backlog_iteration      = ""
+  bugs_behavior          = "asRequirements"
+  default_iteration      = "@CurrentIteration"
+  project_id             = data.azuredevops_project.sandbox.id
+  team_id                = azuredevops_team.developers.id
+  visible_backlog_levels = ["Microsoft.EpicCategory", "Microsoft.FeatureCategory", "Microsoft.RequirementCategory"]
+  working_days           = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+}
@@ -0,0 +1,55 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider"
+	"testing"
+)
+
+// ProtoV6ProviderFactories instantiates the provider in-process for the acceptance tests.
+var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"azuredevops": providerserver.NewProtocol6WithError(provider.New("test")()),
+}
+
+// CassetteProviderFactories instantiates in-process a provider recording its interactions to the cassette at path, or
+// replaying them from it.
+func CassetteProviderFactories(mode networking.CassetteMode, path string) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"azuredevops": providerserver.NewProtocol6WithError(provider.NewWithCassette("test", mode, path)()),
+	}
+}
+
+// NewClient returns the clients of the provider targeting a fake server, used by the tests to check the state of the
+// server, e.g. that the resources were destroyed.
+func NewClient(server *fakeserver.Server) *clients.AzureDevOpsClient {
+	return clients.NewAzureDevOpsClient(context.Background(), &clients.AzureDevOpsClientConfig{
+		HttpClient:      server.Client(),
+		OrganizationUrl: server.URL,
+		ProviderVersion: "test",
+		TokenProvider:   networking.NewPersonalAccessTokenProvider("acctest"),
+	})
+}
+
+// NewServer starts a fake server closed at the end of the test.
+func NewServer(t *testing.T, config *fakeserver.ServerConfig) *fakeserver.Server {
+	server := fakeserver.NewServer(config)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// ProviderConfig returns the configuration of a provider targeting an organization, e.g. the url of a fake server
+// started with fakeserver.NewServer.
+func ProviderConfig(organizationUrl string) string {
+	return fmt.Sprintf(`
+provider "azuredevops" {
+  organization_url      = %q
+  personal_access_token = "acctest"
+}
+`, organizationUrl)
+}
@@ -0,0 +1,685 @@
+package fakeserver
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	operationStatusInProgress = "inProgress"
+	operationStatusQueued     = "queued"
+	operationStatusSucceeded  = "succeeded"
+
+	projectStateCreatePending = "createPending"
+	projectStateDeleted       = "deleted"
+	projectStateDeleting      = "deleting"
+	projectStateWellFormed    = "wellFormed"
+
+	featureStateDisabled = "disabled"
+	featureStateEnabled  = "enabled"
+)
+
+type operation struct {
+	complete func()
+	id       uuid.UUID
+	polls    int
+	status   string
+}
+
+type project struct {
+	features          map[string]string
+	generalSettings   *pipelines.PipelineGeneralSettings
+	properties        map[string]interface{}
+	retentionSettings *pipelines.PipelineRetentionSettings
+	teamSettings      map[uuid.UUID]*teamSettings
+	teams             []*core.WebApiTeam
+	value             *core.TeamProject
+}
+
+func systemProcesses() []core.Process {
+	return []core.Process{
+		{Id: utils.UUID("adcc42ab-9882-485e-a3ed-7678f01f66bc"), Name: utils.String("Agile"), Description: utils.String("This template is flexible and will work great for most teams using Agile planning methods, including those practicing Scrum."), IsDefault: utils.Bool(true), Type: utils.String("system")},
+		{Id: utils.UUID("b8a3a935-7e91-48b8-a94c-606d37c3e9f2"), Name: utils.String("Basic"), Description: utils.String("This template is flexible for any process and great for teams getting started with Azure DevOps."), IsDefault: utils.Bool(false), Type: utils.String("system")},
+		{Id: utils.UUID("27450541-8e31-4150-9947-dc59f998fc01"), Name: utils.String("CMMI"), Description: utils.String("This template is for more formal projects requiring a framework for process improvement and an auditable record of decisions."), IsDefault: utils.Bool(false), Type: utils.String("system")},
+		{Id: utils.UUID("6b724908-ef14-45cf-84f8-768b5384da45"), Name: utils.String("Scrum"), Description: utils.String("This template is for teams who follow the Scrum framework."), IsDefault: utils.Bool(false), Type: utils.String("system")},
+	}
+}
+
+// contributedFeatures returns the catalogue of the features of the organization: the services of the projects, a
+// preview which can be enabled for a project and a preview which can only be enabled by users.
+func contributedFeatures() []core.ContributedFeature {
+	projectScopes := []core.ContributedFeatureSettingScope{
+		{SettingScope: utils.String(core.FeatureSettingScopeProject), UserScoped: utils.Bool(false)},
+	}
+	userScopes := []core.ContributedFeatureSettingScope{
+		{SettingScope: utils.String("host"), UserScoped: utils.Bool(true)},
+	}
+	return []core.ContributedFeature{
+		{Id: utils.String(core.ProjectFeatureArtifacts), Name: utils.String("Artifacts"), DefaultState: utils.Bool(true), Scopes: &projectScopes},
+		{Id: utils.String(core.ProjectFeatureBoards), Name: utils.String("Boards"), DefaultState: utils.Bool(true), Scopes: &projectScopes},
+		{Id: utils.String(core.ProjectFeaturePipelines), Name: utils.String("Pipelines"), DefaultState: utils.Bool(true), Scopes: &projectScopes},
+		{Id: utils.String(core.ProjectFeatureRepositories), Name: utils.String("Repos"), DefaultState: utils.Bool(true), Scopes: &projectScopes},
+		{Id: utils.String(core.ProjectFeatureTestPlans), Name: utils.String("Test Plans"), DefaultState: utils.Bool(true), Scopes: &projectScopes},
+		{Id: utils.String("ms.vss-work-web.new-boards-hub-feature"), Name: utils.String("New Boards Hubs"), Description: utils.String("Use the new version of the Boards hubs."), DefaultState: utils.Bool(false), Scopes: &projectScopes},
+		{Id: utils.String("ms.vss-build-web.run-multi-stage-pipelines-preview-feature"), Name: utils.String("Multi-stage pipelines"), DefaultState: utils.Bool(false), Scopes: &userScopes},
+	}
+}
+
+func (s *Server) registerCoreRoutes() {
+	s.router.handle(http.MethodGet, "_apis/FeatureManagement/Features", s.getFeatures)
+	s.router.handle(http.MethodPost, "_apis/FeatureManagement/FeatureStatesQuery/host/project/{projectId}", s.queryFeatureStates)
+	s.router.handle(http.MethodPatch, "_apis/FeatureManagement/FeatureStates/host/project/{projectId}/{featureId}", s.updateFeatureState)
+	s.router.handle(http.MethodGet, "_apis/operations/{operationId}", s.getOperation)
+	s.router.handle(http.MethodGet, "_apis/process/processes", s.getProcesses)
+	s.router.handle(http.MethodGet, "_apis/projects", s.getProjects)
+	s.router.handle(http.MethodPost, "_apis/projects", s.createProject)
+	s.router.handle(http.MethodGet, "_apis/projects/{projectId}", s.getProject)
+	s.router.handle(http.MethodPatch, "_apis/projects/{projectId}", s.updateProject)
+	s.router.handle(http.MethodDelete, "_apis/projects/{projectId}", s.deleteProject)
+	s.router.handle(http.MethodGet, "_apis/projects/{projectId}/properties", s.getProjectProperties)
+	s.router.handle(http.MethodPatch, "_apis/projects/{projectId}/properties", s.updateProjectProperties)
+	s.router.handle(http.MethodGet, "_apis/projects/{projectId}/teams", s.getTeams)
+	s.router.handle(http.MethodPost, "_apis/projects/{projectId}/teams", s.createTeam)
+	s.router.handle(http.MethodGet, "_apis/projects/{projectId}/teams/{teamId}", s.getTeam)
+	s.router.handle(http.MethodPatch, "_apis/projects/{projectId}/teams/{teamId}", s.updateTeam)
+	s.router.handle(http.MethodDelete, "_apis/projects/{projectId}/teams/{teamId}", s.deleteTeam)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{projectId}", s.migrateProjectProcess)
+}
+
+// Private Methods
+
+func (s *Server) createProject(req *request) (any, error) {
+	var body core.TeamProject
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The project name is required.")
+	}
+	if p := s.findProject(*body.Name); p != nil {
+		return nil, conflict("TF200019: The following project already exists on the Azure DevOps Server: %s.", *body.Name)
+	}
+	if p := s.findDeletedProject(*body.Name); p != nil {
+		return nil, conflict("TF200019: The following project already exists on the Azure DevOps Server: %s. The project has been deleted and can be restored from the recycle bin.", *body.Name)
+	}
+
+	processTemplateId := ""
+	versionControl := "Git"
+	if body.Capabilities != nil {
+		processTemplateId = (*body.Capabilities)[core.CapabilitiesProcessTemplate][core.CapabilitiesProcessTemplateTypeId]
+		if v := (*body.Capabilities)[core.CapabilitiesVersionControl][core.CapabilitiesVersionControlType]; v != "" {
+			versionControl = v
+		}
+	}
+	if s.findProcess(processTemplateId) == nil {
+		return nil, badRequest("VS402362: The process template '%s' does not exist.", processTemplateId)
+	}
+
+	id := uuid.New()
+	visibility := "private"
+	if body.Visibility != nil {
+		visibility = *body.Visibility
+	}
+	state := core.ProjectState(projectStateCreatePending)
+	p := &project{
+		value: &core.TeamProject{
+			Capabilities: &map[string]map[string]string{
+				core.CapabilitiesProcessTemplate: {core.CapabilitiesProcessTemplateTypeId: processTemplateId},
+				core.CapabilitiesVersionControl:  {core.CapabilitiesVersionControlType: versionControl},
+			},
+			Description:    emptyToNil(body.Description),
+			Id:             &id,
+			LastUpdateTime: &core.Time{Time: time.Now().UTC()},
+			Name:           body.Name,
+			Revision:       new(uint64),
+			State:          &state,
+			Url:            utils.String(s.URL + "/_apis/projects/" + id.String()),
+			Visibility:     &visibility,
+		},
+	}
+	s.projects[id] = p
+
+	return s.newOperation(func() {
+		s.provisionProject(p)
+	}), nil
+}
+
+// deleteProject moves a project to the recycle bin, or deletes it permanently when it is already in the recycle bin.
+func (s *Server) deleteProject(req *request) (any, error) {
+	if p := s.findDeletedProject(req.params["projectId"]); p != nil {
+		return s.newOperation(func() {
+			delete(s.projects, *p.value.Id)
+		}), nil
+	}
+
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	state := core.ProjectState(projectStateDeleting)
+	p.value.State = &state
+	return s.newOperation(func() {
+		deleted := core.ProjectState(projectStateDeleted)
+		p.value.State = &deleted
+		s.touchProject(p)
+	}), nil
+}
+
+// findDeletedProject returns the project, in the recycle bin, with the given ID or name.
+func (s *Server) findDeletedProject(idOrName string) *project {
+	for _, p := range s.projects {
+		if string(*p.value.State) != projectStateDeleted {
+			continue
+		}
+		if strings.EqualFold(p.value.Id.String(), idOrName) || strings.EqualFold(*p.value.Name, idOrName) {
+			return p
+		}
+	}
+	return nil
+}
+
+// findFeature returns the feature of the catalogue with the given ID.
+func findFeature(featureId string) *core.ContributedFeature {
+	for _, feature := range contributedFeatures() {
+		if strings.EqualFold(*feature.Id, featureId) {
+			return &feature
+		}
+	}
+	return nil
+}
+
+// findProcess returns the process with the given ID.
+func (s *Server) findProcess(id string) *core.Process {
+	for i := range s.processes {
+		if strings.EqualFold(s.processes[i].Id.String(), id) {
+			return &s.processes[i]
+		}
+	}
+	return nil
+}
+
+// findProject returns the project, not deleted, with the given ID or name.
+func (s *Server) findProject(idOrName string) *project {
+	for _, p := range s.projects {
+		if string(*p.value.State) == projectStateDeleted {
+			continue
+		}
+		if strings.EqualFold(p.value.Id.String(), idOrName) || strings.EqualFold(*p.value.Name, idOrName) {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) findTeam(p *project, idOrName string) (int, *core.WebApiTeam) {
+	for i, team := range p.teams {
+		if strings.EqualFold(team.Id.String(), idOrName) || strings.EqualFold(*team.Name, idOrName) {
+			return i, team
+		}
+	}
+	return -1, nil
+}
+
+func (s *Server) createTeam(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body core.WebApiTeam
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The team name is required.")
+	}
+	if _, team := s.findTeam(p, *body.Name); team != nil {
+		return nil, conflict("VS403369: The team name '%s' is already in use.", *body.Name)
+	}
+
+	return s.addTeam(p, *body.Name, *utils.IfThenElse[*string](body.Description != nil, body.Description, utils.EmptyString)), nil
+}
+
+func (s *Server) addTeam(p *project, name string, description string) *core.WebApiTeam {
+	id := uuid.New()
+	team := &core.WebApiTeam{
+		Description: &description,
+		Id:          &id,
+		Name:        &name,
+		ProjectId:   p.value.Id,
+		ProjectName: p.value.Name,
+		Url:         utils.String(fmt.Sprintf("%s/_apis/projects/%s/teams/%s", s.URL, p.value.Id, id)),
+	}
+	p.teams = append(p.teams, team)
+
+	// A team is backed by a security group of the project with the same ID
+	group := s.addProjectGroup(p, name, description)
+	group.id = id
+	if validUsers := s.findProjectGroup(p, "Project Valid Users"); validUsers != nil {
+		validUsers.members = append(validUsers.members, group.descriptor)
+	}
+	return team
+}
+
+func (s *Server) deleteTeam(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	i, team := s.findTeam(p, req.params["teamId"])
+	if team == nil {
+		return nil, s.teamNotFound(req)
+	}
+	if p.value.DefaultTeam != nil && *p.value.DefaultTeam.Id == *team.Id {
+		return nil, badRequest("VS403372: The default team of a project cannot be deleted.")
+	}
+
+	p.teams = append(p.teams[:i], p.teams[i+1:]...)
+	if group := s.findTeamGroup(team); group != nil {
+		s.removeSubject(group)
+	}
+	return nil, nil
+}
+
+func (s *Server) getFeatures(_ *request) (any, error) {
+	return collection(contributedFeatures()), nil
+}
+
+func (s *Server) getOperation(req *request) (any, error) {
+	id, err := uuid.Parse(req.params["operationId"])
+	if err != nil {
+		return nil, notFound("The operation '%s' does not exist.", req.params["operationId"])
+	}
+
+	op, ok := s.operations[id]
+	if !ok {
+		return nil, notFound("The operation '%s' does not exist.", id)
+	}
+
+	if op.status != operationStatusSucceeded {
+		if op.polls < s.config.PendingPolls {
+			op.polls++
+			op.status = operationStatusInProgress
+		} else {
+			op.status = operationStatusSucceeded
+			op.complete()
+		}
+	}
+
+	return &core.Operation{
+		Id:     &op.id,
+		Status: utils.String(op.status),
+		Url:    utils.String(s.URL + "/_apis/operations/" + op.id.String()),
+	}, nil
+}
+
+func (s *Server) getProcesses(_ *request) (any, error) {
+	return collection(s.processes), nil
+}
+
+func (s *Server) getProject(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *p.value
+	if !strings.EqualFold(req.query("includeCapabilities"), "true") {
+		result.Capabilities = nil
+	}
+	return &result, nil
+}
+
+func (s *Server) getProjectProperties(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	if req.query("keys") != "" {
+		keys = strings.Split(req.query("keys"), ",")
+	}
+
+	properties := []core.ProjectProperty{}
+	for name, value := range p.properties {
+		if len(keys) > 0 && !containsFold(keys, name) {
+			continue
+		}
+		properties = append(properties, core.ProjectProperty{Name: utils.String(name), Value: value})
+	}
+	sort.Slice(properties, func(i, j int) bool {
+		return *properties[i].Name < *properties[j].Name
+	})
+	return collection(properties), nil
+}
+
+// getProjectParam returns the project referenced by the 'projectId' parameter of the route, by ID or name.
+func (s *Server) getProjectParam(req *request) (*project, error) {
+	idOrName := req.params["projectId"]
+	p := s.findProject(idOrName)
+	if p == nil {
+		return nil, notFound("VS800075: The project with id '%s' does not exist, or you do not have permission to access it.", idOrName)
+	}
+	return p, nil
+}
+
+func (s *Server) getProjects(req *request) (any, error) {
+	stateFilter := req.query("stateFilter")
+	if stateFilter == "" {
+		stateFilter = projectStateWellFormed
+	}
+
+	var projects []core.TeamProjectReference
+	for _, p := range s.sortedProjects() {
+		if !strings.EqualFold(stateFilter, "all") && !strings.EqualFold(stateFilter, string(*p.value.State)) {
+			continue
+		}
+		projects = append(projects, core.TeamProjectReference{
+			Description:    p.value.Description,
+			Id:             p.value.Id,
+			LastUpdateTime: p.value.LastUpdateTime,
+			Name:           p.value.Name,
+			Revision:       p.value.Revision,
+			State:          p.value.State,
+			Url:            p.value.Url,
+			Visibility:     p.value.Visibility,
+		})
+	}
+
+	top, _ := strconv.Atoi(req.query("$top"))
+	return collection(paginate(s, req, projects, top)), nil
+}
+
+func (s *Server) getTeam(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, team := s.findTeam(p, req.params["teamId"])
+	if team == nil {
+		return nil, s.teamNotFound(req)
+	}
+	return team, nil
+}
+
+func (s *Server) getTeams(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return collection(skipTop(s, req, p.teams)), nil
+}
+
+// migrateProjectProcess changes the process of a project, which Azure DevOps refuses between two system processes.
+func (s *Server) migrateProjectProcess(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body core.ProcessIdModel
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.TypeId == nil {
+		return nil, badRequest("The process type ID is required.")
+	}
+
+	target := s.findProcess(body.TypeId.String())
+	if target == nil {
+		return nil, notFound("VS402362: The process template '%s' does not exist.", body.TypeId.String())
+	}
+	current := s.findProcess((*p.value.Capabilities)[core.CapabilitiesProcessTemplate][core.CapabilitiesProcessTemplateTypeId])
+	if current != nil && current != target && *current.Type == "system" && *target.Type == "system" {
+		return nil, badRequest("VS403390: The project '%s' cannot be migrated from the system process '%s' to the system process '%s'.", *p.value.Name, *current.Name, *target.Name)
+	}
+
+	(*p.value.Capabilities)[core.CapabilitiesProcessTemplate][core.CapabilitiesProcessTemplateTypeId] = target.Id.String()
+	s.touchProject(p)
+	return &core.ProcessMigrationResultModel{ProcessId: target.Id, ProjectId: p.value.Id}, nil
+}
+
+func (s *Server) newOperation(complete func()) *core.OperationReference {
+	op := &operation{
+		complete: complete,
+		id:       uuid.New(),
+		status:   operationStatusQueued,
+	}
+	if s.config.PendingPolls == 0 {
+		op.status = operationStatusSucceeded
+		complete()
+	}
+	s.operations[op.id] = op
+
+	return &core.OperationReference{
+		Id:     &op.id,
+		Status: utils.String(op.status),
+		Url:    utils.String(s.URL + "/_apis/operations/" + op.id.String()),
+	}
+}
+
+// provisionProject creates what Azure DevOps creates along with a project: the default team, the default groups,
+// the root areas and iterations, and the default settings.
+func (s *Server) provisionProject(p *project) {
+	state := core.ProjectState(projectStateWellFormed)
+	p.value.State = &state
+	p.features = map[string]string{
+		core.ProjectFeatureArtifacts:    featureStateEnabled,
+		core.ProjectFeatureBoards:       featureStateEnabled,
+		core.ProjectFeaturePipelines:    featureStateEnabled,
+		core.ProjectFeatureRepositories: featureStateEnabled,
+		core.ProjectFeatureTestPlans:    featureStateEnabled,
+	}
+	p.generalSettings = defaultPipelineGeneralSettings()
+	p.properties = map[string]interface{}{
+		"System.CurrentProcessTemplateId": (*p.value.Capabilities)[core.CapabilitiesProcessTemplate][core.CapabilitiesProcessTemplateTypeId],
+		"System.SourceControlGitEnabled":  strconv.FormatBool(strings.EqualFold((*p.value.Capabilities)[core.CapabilitiesVersionControl][core.CapabilitiesVersionControlType], "Git")),
+	}
+	p.retentionSettings = defaultPipelineRetentionSettings()
+
+	s.createProjectGroups(p)
+
+	team := s.addTeam(p, *p.value.Name+" Team", "The default project team.")
+	p.value.DefaultTeam = &core.WebApiTeamRef{Id: team.Id, Name: team.Name, Url: team.Url}
+
+	s.createRootClassificationNodes(p)
+}
+
+func (s *Server) queryFeatureStates(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body core.ContributedFeatureStateQuery
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	// The states are keyed by the IDs of the catalogue, whatever the case of the requested IDs. The features missing
+	// from the catalogue are left out of the response.
+	featureStates := map[string]core.ContributedFeatureState{}
+	if body.FeatureIds != nil {
+		for _, featureId := range *body.FeatureIds {
+			feature := findFeature(featureId)
+			if feature == nil {
+				continue
+			}
+			featureId = *feature.Id
+			state, ok := p.features[featureId]
+			if !ok {
+				state = utils.IfThenElse[string](feature.DefaultState != nil && *feature.DefaultState, featureStateEnabled, featureStateDisabled)
+			}
+			featureStates[featureId] = core.ContributedFeatureState{FeatureId: utils.String(featureId), State: utils.String(state)}
+		}
+	}
+
+	body.FeatureStates = &featureStates
+	return &body, nil
+}
+
+func (s *Server) sortedProjects() []*project {
+	var projects []*project
+	for _, p := range s.projects {
+		projects = append(projects, p)
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return strings.ToLower(*projects[i].value.Name) < strings.ToLower(*projects[j].value.Name)
+	})
+	return projects
+}
+
+func (s *Server) teamNotFound(req *request) error {
+	return notFound("VS403363: The team with id or name '%s' does not exist.", req.params["teamId"])
+}
+
+func (s *Server) touchProject(p *project) {
+	revision := *p.value.Revision + 1
+	p.value.LastUpdateTime = &core.Time{Time: time.Now().UTC()}
+	p.value.Revision = &revision
+}
+
+func (s *Server) updateFeatureState(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body core.ContributedFeatureState
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.State == nil || (*body.State != featureStateEnabled && *body.State != featureStateDisabled && *body.State != core.FeatureStateUndefined) {
+		return nil, badRequest("The state of a feature must be 'enabled', 'disabled' or 'undefined'.")
+	}
+
+	feature := findFeature(req.params["featureId"])
+	if feature == nil {
+		return nil, notFound("The feature '%s' does not exist.", req.params["featureId"])
+	}
+	featureId := *feature.Id
+
+	// An undefined state restores the default state of the feature
+	if *body.State == core.FeatureStateUndefined {
+		delete(p.features, featureId)
+	} else {
+		p.features[featureId] = *body.State
+	}
+	return &core.ContributedFeatureState{FeatureId: &featureId, Scope: body.Scope, State: body.State}, nil
+}
+
+// updateProject updates a project, or restores it from the recycle bin when its state is set to wellFormed.
+func (s *Server) updateProject(req *request) (any, error) {
+	var body core.TeamProject
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	if p := s.findDeletedProject(req.params["projectId"]); p != nil && body.State != nil && string(*body.State) == projectStateWellFormed {
+		if other := s.findProject(*p.value.Name); other != nil {
+			return nil, conflict("TF200019: The following project already exists on the Azure DevOps Server: %s.", *p.value.Name)
+		}
+		return s.newOperation(func() {
+			state := core.ProjectState(projectStateWellFormed)
+			p.value.State = &state
+			s.touchProject(p)
+		}), nil
+	}
+
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+	if body.Name != nil {
+		if other := s.findProject(*body.Name); other != nil && other != p {
+			return nil, conflict("TF200019: The following project already exists on the Azure DevOps Server: %s.", *body.Name)
+		}
+	}
+
+	return s.newOperation(func() {
+		if body.Description != nil {
+			p.value.Description = emptyToNil(body.Description)
+		}
+		if body.Name != nil {
+			p.value.Name = body.Name
+		}
+		if body.Visibility != nil {
+			p.value.Visibility = body.Visibility
+		}
+		s.touchProject(p)
+	}), nil
+}
+
+func (s *Server) updateProjectProperties(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []core.JsonPatchOperation
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	for _, operation := range body {
+		name := strings.ReplaceAll(strings.ReplaceAll(strings.TrimPrefix(operation.Path, "/"), "~1", "/"), "~0", "~")
+		if strings.HasPrefix(name, "System.") {
+			return nil, badRequest("VS402478: The property '%s' is reserved and cannot be modified.", name)
+		}
+		switch operation.Op {
+		case "add", "replace":
+			p.properties[name] = operation.Value
+		case "remove":
+			delete(p.properties, name)
+		default:
+			return nil, badRequest("The patch operation '%s' is not supported.", operation.Op)
+		}
+	}
+	s.touchProject(p)
+	return nil, nil
+}
+
+func (s *Server) updateTeam(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, team := s.findTeam(p, req.params["teamId"])
+	if team == nil {
+		return nil, s.teamNotFound(req)
+	}
+
+	var body core.WebApiTeam
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name != nil && *body.Name != "" {
+		if _, other := s.findTeam(p, *body.Name); other != nil && other != team {
+			return nil, conflict("VS403369: The team name '%s' is already in use.", *body.Name)
+		}
+		team.Name = body.Name
+	}
+	if body.Description != nil {
+		team.Description = body.Description
+	}
+	if group := s.findTeamGroup(team); group != nil {
+		group.description = *team.Description
+		group.displayName = *team.Name
+	}
+	return team, nil
+}
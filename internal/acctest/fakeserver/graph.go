@@ -0,0 +1,693 @@
+package fakeserver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/location"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"golang.org/x/exp/slices"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	subjectKindGroup = "group"
+	subjectKindUser  = "user"
+
+	originAad  = "aad"
+	originVsts = "vsts"
+
+	authenticatedUserId = "8b2b0c6e-4d1e-4c5f-9a52-7c3f0f6a1d2e"
+	groupSidPrefix      = "S-1-9-1551374245-"
+	tenantId            = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+)
+
+// subject is a user or a group of the organization, exposed by both the Graph and the Identities APIs.
+type subject struct {
+	description      string
+	descriptor       string
+	displayName      string
+	id               uuid.UUID
+	kind             string
+	legacyDescriptor string
+	mail             string
+	materialized     bool
+	members          []string
+	origin           string
+	originId         string
+	projectId        *uuid.UUID
+}
+
+// AddUser adds a user of the organization and returns its subject descriptor. Users are referenced by their mail
+// address in the configuration.
+func (s *Server) AddUser(displayName string, mail string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	originId := uuid.New()
+	sub := &subject{
+		displayName:      displayName,
+		descriptor:       "aad." + encodeDescriptor(originId.String()),
+		id:               uuid.New(),
+		kind:             subjectKindUser,
+		legacyDescriptor: fmt.Sprintf("Microsoft.IdentityModel.Claims.ClaimsIdentity;%s\\%s", tenantId, mail),
+		mail:             mail,
+		materialized:     true,
+		origin:           originAad,
+		originId:         originId.String(),
+	}
+	s.subjects[sub.descriptor] = sub
+	return sub.descriptor
+}
+
+// AddEntraGroup adds a Microsoft Entra ID group which is not known by the organization yet, like the groups the
+// provider materializes with their origin ID. It returns the origin ID of the group.
+func (s *Server) AddEntraGroup(displayName string, mail string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	originId := uuid.New()
+	sub := &subject{
+		displayName:      displayName,
+		descriptor:       "aadgp." + encodeDescriptor(originId.String()),
+		id:               uuid.New(),
+		kind:             subjectKindGroup,
+		legacyDescriptor: "Microsoft.TeamFoundation.Identity;" + groupSidPrefix + originId.String(),
+		mail:             mail,
+		origin:           originAad,
+		originId:         originId.String(),
+	}
+	s.subjects[sub.descriptor] = sub
+	return sub.originId
+}
+
+func (s *Server) registerGraphRoutes() {
+	s.router.handle(http.MethodGet, "_apis/graph/descriptors/{storageKey}", s.getDescriptor)
+	s.router.handle(http.MethodGet, "_apis/graph/groups", s.getGroups)
+	s.router.handle(http.MethodPost, "_apis/graph/groups", s.createGroup)
+	s.router.handle(http.MethodGet, "_apis/graph/groups/{descriptor}", s.getGroup)
+	s.router.handle(http.MethodPatch, "_apis/graph/groups/{descriptor}", s.updateGroup)
+	s.router.handle(http.MethodDelete, "_apis/graph/groups/{descriptor}", s.deleteGroup)
+	s.router.handle(http.MethodGet, "_apis/graph/memberships/{descriptor}", s.getMemberships)
+	s.router.handle(http.MethodPut, "_apis/graph/memberships/{memberDescriptor}/{containerDescriptor}", s.addMembership)
+	s.router.handle(http.MethodDelete, "_apis/graph/memberships/{memberDescriptor}/{containerDescriptor}", s.removeMembership)
+	s.router.handle(http.MethodGet, "_apis/graph/users", s.getUsers)
+	s.router.handle(http.MethodPost, "_apis/graph/users", s.createUser)
+	s.router.handle(http.MethodGet, "_apis/graph/users/{descriptor}", s.getUser)
+	s.router.handle(http.MethodGet, "_apis/identities", s.getIdentities)
+	s.router.handle(http.MethodGet, "_apis/identities/groups", s.getIdentityGroups)
+	s.router.handle(http.MethodPost, "_apis/identitypicker/identities", s.pickIdentities)
+}
+
+func (s *Server) registerLocationRoutes() {
+	s.router.handle(http.MethodGet, "_apis/connectionData", s.getConnectionData)
+	s.router.handle(http.MethodGet, "_apis/resourceAreas/{areaId}", s.getResourceArea)
+	s.router.handle(http.MethodOptions, "_apis", s.getResourceLocations)
+}
+
+// Private Methods
+
+func (s *Server) addMembership(req *request) (any, error) {
+	member, container, err := s.getMembershipParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(container.members, member.descriptor) {
+		container.members = append(container.members, member.descriptor)
+	}
+	return &graph.GraphMembership{ContainerDescriptor: &container.descriptor, MemberDescriptor: &member.descriptor}, nil
+}
+
+func (s *Server) createGroup(req *request) (any, error) {
+	var body struct {
+		Description *string `json:"description"`
+		DisplayName *string `json:"displayName"`
+		OriginId    *string `json:"originId"`
+	}
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	if body.OriginId != nil {
+		sub := s.findSubjectByOriginId(*body.OriginId, subjectKindGroup)
+		if sub == nil {
+			return nil, notFound("VS860015: The group with origin id '%s' does not exist.", *body.OriginId)
+		}
+		sub.materialized = true
+		return s.graphGroup(sub), nil
+	}
+
+	p, err := s.getScopeProject(req)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, badRequest("VS860005: Creating groups requires a scope descriptor.")
+	}
+	if body.DisplayName == nil || strings.TrimSpace(*body.DisplayName) == "" {
+		return nil, badRequest("The display name of the group is required.")
+	}
+	if s.findProjectGroup(p, *body.DisplayName) != nil {
+		return nil, conflict("VS860023: The group '%s' already exists in project '%s'.", *body.DisplayName, *p.value.Name)
+	}
+
+	description := ""
+	if body.Description != nil {
+		description = *body.Description
+	}
+	return s.graphGroup(s.addProjectGroup(p, *body.DisplayName, description)), nil
+}
+
+func (s *Server) addProjectGroup(p *project, displayName string, description string) *subject {
+	sid := fmt.Sprintf("%s%d", groupSidPrefix, s.nextId())
+	sub := &subject{
+		description:      description,
+		descriptor:       "vssgp." + encodeDescriptor(sid),
+		displayName:      displayName,
+		id:               uuid.New(),
+		kind:             subjectKindGroup,
+		legacyDescriptor: "Microsoft.TeamFoundation.Identity;" + sid,
+		materialized:     true,
+		origin:           originVsts,
+		originId:         uuid.NewString(),
+		projectId:        p.value.Id,
+	}
+	s.subjects[sub.descriptor] = sub
+	return sub
+}
+
+// createProjectGroups creates the default groups of a project, the Project Valid Users group containing the others.
+func (s *Server) createProjectGroups(p *project) {
+	validUsers := s.addProjectGroup(p, "Project Valid Users", "Members of this group have access to the team project.")
+	for _, name := range []string{"Build Administrators", "Contributors", "Project Administrators", "Readers"} {
+		group := s.addProjectGroup(p, name, "")
+		validUsers.members = append(validUsers.members, group.descriptor)
+	}
+}
+
+func (s *Server) createUser(req *request) (any, error) {
+	var body graph.GraphUserOriginIdCreationContext
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.OriginId == nil {
+		return nil, badRequest("The origin id of the user is required.")
+	}
+
+	sub := s.findSubjectByOriginId(*body.OriginId, subjectKindUser)
+	if sub == nil {
+		return nil, notFound("VS860015: The user with origin id '%s' does not exist.", *body.OriginId)
+	}
+	sub.materialized = true
+	return s.graphUser(sub), nil
+}
+
+func (s *Server) deleteGroup(req *request) (any, error) {
+	sub, err := s.getSubjectParam(req, "descriptor", subjectKindGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeSubject(sub)
+	return nil, nil
+}
+
+func (s *Server) findProjectGroup(p *project, displayName string) *subject {
+	for _, sub := range s.subjects {
+		if sub.projectId != nil && *sub.projectId == *p.value.Id && strings.EqualFold(sub.displayName, displayName) {
+			return sub
+		}
+	}
+	return nil
+}
+
+func (s *Server) findSubjectByLegacyDescriptor(descriptor string) *subject {
+	for _, sub := range s.subjects {
+		if strings.EqualFold(sub.legacyDescriptor, descriptor) {
+			return sub
+		}
+	}
+	return nil
+}
+
+func (s *Server) findSubjectByOriginId(originId string, kind string) *subject {
+	for _, sub := range s.subjects {
+		if sub.kind == kind && strings.EqualFold(sub.originId, originId) {
+			return sub
+		}
+	}
+	return nil
+}
+
+func (s *Server) findTeamGroup(team *core.WebApiTeam) *subject {
+	for _, sub := range s.subjects {
+		if sub.kind == subjectKindGroup && sub.id == *team.Id {
+			return sub
+		}
+	}
+	return nil
+}
+
+func (s *Server) getDescriptor(req *request) (any, error) {
+	storageKey := req.params["storageKey"]
+	if p := s.findProject(storageKey); p != nil {
+		return &graph.GraphDescriptorResult{Value: utils.String("scp." + encodeDescriptor(p.value.Id.String()))}, nil
+	}
+	for _, sub := range s.subjects {
+		if strings.EqualFold(sub.id.String(), storageKey) {
+			return &graph.GraphDescriptorResult{Value: &sub.descriptor}, nil
+		}
+	}
+	return nil, notFound("VS860018: The storage key '%s' does not exist.", storageKey)
+}
+
+func (s *Server) getGroup(req *request) (any, error) {
+	sub, err := s.getSubjectParam(req, "descriptor", subjectKindGroup)
+	if err != nil {
+		return nil, err
+	}
+	return s.graphGroup(sub), nil
+}
+
+func (s *Server) getGroups(req *request) (any, error) {
+	p, err := s.getScopeProject(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []graph.GraphGroup
+	for _, sub := range s.sortedSubjects(subjectKindGroup) {
+		if p != nil && (sub.projectId == nil || *sub.projectId != *p.value.Id) {
+			continue
+		}
+		groups = append(groups, *s.graphGroup(sub))
+	}
+	return collection(paginate(s, req, groups, 0)), nil
+}
+
+func (s *Server) getIdentities(req *request) (any, error) {
+	var subjects []*subject
+	if descriptors := req.query("descriptors"); descriptors != "" {
+		for _, descriptor := range splitList(descriptors) {
+			if sub := s.findSubjectByLegacyDescriptor(descriptor); sub != nil {
+				subjects = append(subjects, sub)
+			}
+		}
+	} else if descriptors = req.query("subjectDescriptors"); descriptors != "" {
+		for _, descriptor := range splitList(descriptors) {
+			if sub, ok := s.subjects[descriptor]; ok {
+				subjects = append(subjects, sub)
+			}
+		}
+	} else {
+		return nil, badRequest("One of 'descriptors' or 'subjectDescriptors' is required.")
+	}
+
+	direct := strings.EqualFold(req.query("queryMembership"), "direct")
+	var identities []core.Identity
+	for _, sub := range subjects {
+		identities = append(identities, *s.identity(sub, direct))
+	}
+	return collection(identities), nil
+}
+
+func (s *Server) getIdentityGroups(req *request) (any, error) {
+	var identities []core.Identity
+	for _, scopeId := range splitList(req.query("scopeIds")) {
+		p := s.findProject(scopeId)
+		if p == nil {
+			return nil, notFound("VS800075: The project with id '%s' does not exist, or you do not have permission to access it.", scopeId)
+		}
+		for _, sub := range s.sortedSubjects(subjectKindGroup) {
+			if sub.projectId != nil && *sub.projectId == *p.value.Id {
+				identities = append(identities, *s.identity(sub, false))
+			}
+		}
+	}
+	return collection(identities), nil
+}
+
+func (s *Server) getMembershipParams(req *request) (*subject, *subject, error) {
+	member, err := s.getSubjectParam(req, "memberDescriptor", "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	container, err := s.getSubjectParam(req, "containerDescriptor", subjectKindGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+	return member, container, nil
+}
+
+func (s *Server) getMemberships(req *request) (any, error) {
+	sub, err := s.getSubjectParam(req, "descriptor", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var memberships []graph.GraphMembership
+	if strings.EqualFold(req.query("direction"), "down") {
+		for _, member := range sub.members {
+			memberships = append(memberships, graph.GraphMembership{ContainerDescriptor: utils.String(sub.descriptor), MemberDescriptor: utils.String(member)})
+		}
+	} else {
+		for _, container := range s.sortedSubjects(subjectKindGroup) {
+			if slices.Contains(container.members, sub.descriptor) {
+				memberships = append(memberships, graph.GraphMembership{ContainerDescriptor: utils.String(container.descriptor), MemberDescriptor: utils.String(sub.descriptor)})
+			}
+		}
+	}
+	return collection(memberships), nil
+}
+
+// getConnectionData returns the identity authenticated by the personal access token of the acceptance tests, which
+// is accepted whatever its value.
+func (s *Server) getConnectionData(_ *request) (any, error) {
+	id := uuid.MustParse(authenticatedUserId)
+	identity := &core.Identity{
+		Descriptor:          utils.String(fmt.Sprintf("Microsoft.IdentityModel.Claims.ClaimsIdentity;%s\\acctest@contoso.com", tenantId)),
+		Id:                  &id,
+		IsActive:            utils.Bool(true),
+		ProviderDisplayName: utils.String("Acceptance Tests"),
+	}
+	return &location.ConnectionData{AuthenticatedUser: identity, AuthorizedUser: identity}, nil
+}
+
+func (s *Server) getResourceArea(req *request) (any, error) {
+	areaId, err := uuid.Parse(req.params["areaId"])
+	if err != nil || areaId.String() != location.ResourceAreaIdGraph {
+		return nil, notFound("The resource area '%s' does not exist.", req.params["areaId"])
+	}
+	return &location.ResourceAreaInfo{Id: &areaId, LocationUrl: utils.String(s.URL), Name: utils.String("Graph")}, nil
+}
+
+// getResourceLocations returns a location per registered route, all of them supporting up to the version of the
+// REST APIs of the server. Like Azure DevOps, the area and the resource of the routes are replaced by the '{area}' and
+// '{resource}' placeholders in their templates.
+func (s *Server) getResourceLocations(_ *request) (any, error) {
+	var locations []networking.ApiResourceLocation
+	for i, route := range s.router.routes {
+		index := slices.Index(route.segments, "_apis")
+		if index < 0 || index+1 >= len(route.segments) {
+			continue
+		}
+
+		segments := slices.Clone(route.segments)
+		area := segments[index+1]
+		resourceName := area
+		if index+2 < len(segments) && !strings.HasPrefix(segments[index+2], "{") {
+			resourceName = segments[index+2]
+			segments[index+1] = "{area}"
+			segments[index+2] = "{resource}"
+		}
+
+		locations = append(locations, networking.ApiResourceLocation{
+			Area:            utils.String(area),
+			Id:              utils.String(uuid.NewSHA1(uuid.NameSpaceURL, []byte(strconv.Itoa(i))).String()),
+			MaxVersion:      &s.config.ApiVersion,
+			MinVersion:      utils.String("1.0"),
+			ReleasedVersion: &s.config.ApiVersion,
+			ResourceName:    utils.String(resourceName),
+			ResourceVersion: utils.Int(1),
+			RouteTemplate:   utils.String(strings.Join(segments, "/")),
+		})
+	}
+	return collection(locations), nil
+}
+
+// getScopeProject returns the project referenced by the 'scopeDescriptor' query parameter, if any.
+func (s *Server) getScopeProject(req *request) (*project, error) {
+	scopeDescriptor := req.query("scopeDescriptor")
+	if scopeDescriptor == "" {
+		return nil, nil
+	}
+
+	projectId, err := decodeDescriptor(strings.TrimPrefix(scopeDescriptor, "scp."))
+	if err != nil || !strings.HasPrefix(scopeDescriptor, "scp.") {
+		return nil, badRequest("VS860008: The scope descriptor '%s' is invalid.", scopeDescriptor)
+	}
+
+	p := s.findProject(projectId)
+	if p == nil {
+		return nil, notFound("VS800075: The project with id '%s' does not exist, or you do not have permission to access it.", projectId)
+	}
+	return p, nil
+}
+
+func (s *Server) getSubjectParam(req *request, param string, kind string) (*subject, error) {
+	sub, ok := s.subjects[req.params[param]]
+	if !ok || (kind != "" && sub.kind != kind) || !sub.materialized {
+		return nil, notFound("VS860002: The subject with descriptor '%s' does not exist.", req.params[param])
+	}
+	return sub, nil
+}
+
+func (s *Server) getUser(req *request) (any, error) {
+	sub, err := s.getSubjectParam(req, "descriptor", subjectKindUser)
+	if err != nil {
+		return nil, err
+	}
+	return s.graphUser(sub), nil
+}
+
+func (s *Server) getUsers(req *request) (any, error) {
+	if _, err := s.getScopeProject(req); err != nil {
+		return nil, err
+	}
+
+	var users []graph.GraphUser
+	for _, sub := range s.sortedSubjects(subjectKindUser) {
+		users = append(users, *s.graphUser(sub))
+	}
+	return collection(paginate(s, req, users, 0)), nil
+}
+
+func (s *Server) graphGroup(sub *subject) *graph.GraphGroup {
+	group := &graph.GraphGroup{
+		Description:      utils.String(sub.description),
+		Descriptor:       utils.String(sub.descriptor),
+		DisplayName:      utils.String(sub.displayName),
+		LegacyDescriptor: utils.String(sub.legacyDescriptor),
+		Origin:           utils.String(sub.origin),
+		OriginId:         utils.String(sub.originId),
+		PrincipalName:    utils.String(s.principalName(sub)),
+		SubjectKind:      utils.String(subjectKindGroup),
+		Url:              utils.String(s.URL + "/_apis/graph/groups/" + sub.descriptor),
+	}
+	if sub.projectId != nil {
+		group.Domain = utils.String(graph.GroupDomainProjectPrefix + sub.projectId.String())
+	} else if sub.origin == originAad {
+		group.Domain = utils.String(tenantId)
+	}
+	if sub.mail != "" {
+		group.MailAddress = utils.String(sub.mail)
+	}
+	return group
+}
+
+func (s *Server) graphUser(sub *subject) *graph.GraphUser {
+	return &graph.GraphUser{
+		Descriptor:       utils.String(sub.descriptor),
+		DisplayName:      utils.String(sub.displayName),
+		Domain:           utils.String(tenantId),
+		LegacyDescriptor: utils.String(sub.legacyDescriptor),
+		MailAddress:      utils.String(sub.mail),
+		MetaType:         utils.String("member"),
+		Origin:           utils.String(sub.origin),
+		OriginId:         utils.String(sub.originId),
+		PrincipalName:    utils.String(sub.mail),
+		SubjectKind:      utils.String(subjectKindUser),
+		Url:              utils.String(s.URL + "/_apis/graph/users/" + sub.descriptor),
+	}
+}
+
+func (s *Server) identity(sub *subject, includeMembers bool) *core.Identity {
+	schemaClassName := "User"
+	account := sub.mail
+	if sub.kind == subjectKindGroup {
+		schemaClassName = "Group"
+		account = sub.displayName
+	}
+
+	properties := map[string]any{
+		"Account":         map[string]any{"$type": "System.String", "$value": account},
+		"SchemaClassName": map[string]any{"$type": "System.String", "$value": schemaClassName},
+	}
+	if sub.mail != "" {
+		properties["Mail"] = map[string]any{"$type": "System.String", "$value": sub.mail}
+	}
+	if sub.description != "" {
+		properties["Description"] = map[string]any{"$type": "System.String", "$value": sub.description}
+	}
+
+	identity := &core.Identity{
+		Descriptor:          utils.String(sub.legacyDescriptor),
+		Id:                  &sub.id,
+		IsActive:            utils.Bool(true),
+		IsContainer:         utils.Bool(sub.kind == subjectKindGroup),
+		Properties:          properties,
+		ProviderDisplayName: utils.String(s.principalName(sub)),
+		SubjectDescriptor:   utils.String(sub.descriptor),
+	}
+	if includeMembers {
+		members := []string{}
+		for _, member := range sub.members {
+			if m, ok := s.subjects[member]; ok {
+				members = append(members, m.legacyDescriptor)
+			}
+		}
+		identity.Members = &members
+	}
+	return identity
+}
+
+func (s *Server) pickIdentities(req *request) (any, error) {
+	var body graph.IdentityPickerRequest
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Query == nil {
+		return nil, badRequest("The query of the identity picker is required.")
+	}
+
+	// The identity picker also searches the directory, which holds the subjects never materialized in the organization
+	var subjects []*subject
+	for _, sub := range s.subjects {
+		subjects = append(subjects, sub)
+	}
+	sort.Slice(subjects, func(i, j int) bool {
+		return s.principalName(subjects[i]) < s.principalName(subjects[j])
+	})
+
+	var identities []graph.IdentityPickerIdentity
+	for _, sub := range subjects {
+		if body.IdentityTypes != nil && !slices.Contains(*body.IdentityTypes, sub.kind) {
+			continue
+		}
+		if !strings.EqualFold(sub.displayName, *body.Query) && !strings.EqualFold(sub.mail, *body.Query) && !strings.EqualFold(s.principalName(sub), *body.Query) {
+			continue
+		}
+
+		entityType := "User"
+		if sub.kind == subjectKindGroup {
+			entityType = "Group"
+		}
+		identity := graph.IdentityPickerIdentity{
+			DisplayName:     utils.String(sub.displayName),
+			EntityId:        utils.String(fmt.Sprintf("vss.ds.v1.%s.%s.%s", sub.origin, sub.kind, sub.originId)),
+			EntityType:      &entityType,
+			OriginDirectory: utils.String(sub.origin),
+			OriginId:        utils.String(sub.originId),
+		}
+		if sub.mail != "" {
+			identity.Mail = utils.String(sub.mail)
+			identity.SamAccountName = utils.String(sub.mail)
+		}
+		if sub.materialized {
+			identity.SubjectDescriptor = utils.String(sub.descriptor)
+		}
+		identities = append(identities, identity)
+	}
+
+	if identities == nil {
+		identities = []graph.IdentityPickerIdentity{}
+	}
+	return &graph.IdentityPickerResponse{
+		Results: &[]graph.IdentityPickerResult{{Identities: &identities, QueryToken: body.Query}},
+	}, nil
+}
+
+// principalName returns the name of a subject qualified by its scope, e.g. '[Sandbox]\Contributors'.
+func (s *Server) principalName(sub *subject) string {
+	if sub.kind == subjectKindUser {
+		return sub.mail
+	}
+	if sub.projectId != nil {
+		if p, ok := s.projects[*sub.projectId]; ok {
+			return fmt.Sprintf("[%s]\\%s", *p.value.Name, sub.displayName)
+		}
+	}
+	if sub.origin == originAad {
+		return fmt.Sprintf("[TEAM FOUNDATION]\\%s", sub.displayName)
+	}
+	return sub.displayName
+}
+
+func (s *Server) removeMembership(req *request) (any, error) {
+	member, container, err := s.getMembershipParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(container.members, member.descriptor) {
+		return nil, notFound("VS860016: '%s' is not a member of '%s'.", member.descriptor, container.descriptor)
+	}
+	container.members = removeItem(container.members, member.descriptor)
+	return nil, nil
+}
+
+// sortedSubjects returns the materialized subjects of the given kind, or all of them, sorted by display name.
+// removeSubject deletes a subject along with its memberships.
+func (s *Server) removeSubject(sub *subject) {
+	delete(s.subjects, sub.descriptor)
+	for _, other := range s.subjects {
+		other.members = removeItem(other.members, sub.descriptor)
+	}
+}
+
+func (s *Server) sortedSubjects(kind string) []*subject {
+	var subjects []*subject
+	for _, sub := range s.subjects {
+		if sub.materialized && (kind == "" || sub.kind == kind) {
+			subjects = append(subjects, sub)
+		}
+	}
+	sort.Slice(subjects, func(i, j int) bool {
+		return s.principalName(subjects[i]) < s.principalName(subjects[j])
+	})
+	return subjects
+}
+
+func (s *Server) updateGroup(req *request) (any, error) {
+	sub, err := s.getSubjectParam(req, "descriptor", subjectKindGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []core.JsonPatchOperation
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	for _, operation := range body {
+		value, _ := operation.Value.(string)
+		switch {
+		case operation.Op != "replace":
+			return nil, badRequest("The patch operation '%s' is not supported.", operation.Op)
+		case operation.Path == "/description":
+			sub.description = value
+		case operation.Path == "/displayName":
+			sub.displayName = value
+		default:
+			return nil, badRequest("The path '%s' cannot be patched.", operation.Path)
+		}
+	}
+	return s.graphGroup(sub), nil
+}
+
+func decodeDescriptor(value string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	return string(decoded), err
+}
+
+func encodeDescriptor(value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
@@ -0,0 +1,537 @@
+package fakeserver
+
+import (
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	environmentResourceTypeKubernetes = "kubernetes"
+)
+
+type agentQueue struct {
+	projectId uuid.UUID
+	value     *pipelines.TaskAgentQueue
+}
+
+type environment struct {
+	projectId uuid.UUID
+	value     *pipelines.EnvironmentInstance
+}
+
+type environmentKubernetes struct {
+	environmentId int
+	value         *pipelines.EnvironmentResourceKubernetes
+}
+
+func defaultPipelineGeneralSettings() *pipelines.PipelineGeneralSettings {
+	return &pipelines.PipelineGeneralSettings{
+		DisableClassicPipelineCreation:   utils.Bool(false),
+		EnforceJobAuthScope:              utils.Bool(true),
+		EnforceJobAuthScopeForReleases:   utils.Bool(true),
+		EnforceReferencedRepoScopedToken: utils.Bool(true),
+		EnforceSettableVar:               utils.Bool(true),
+		PublishPipelineMetadata:          utils.Bool(false),
+		StatusBadgesArePrivate:           utils.Bool(true),
+	}
+}
+
+func defaultPipelineRetentionSettings() *pipelines.PipelineRetentionSettings {
+	return &pipelines.PipelineRetentionSettings{
+		PurgeArtifacts:               &pipelines.RetentionSetting{Max: utils.Int(60), Min: utils.Int(1), Value: utils.Int(30)},
+		PurgePullRequestRuns:         &pipelines.RetentionSetting{Max: utils.Int(30), Min: utils.Int(1), Value: utils.Int(10)},
+		PurgeRuns:                    &pipelines.RetentionSetting{Max: utils.Int(731), Min: utils.Int(30), Value: utils.Int(30)},
+		RetainRunsPerProtectedBranch: &pipelines.RetentionSetting{Max: utils.Int(50), Min: utils.Int(0), Value: utils.Int(3)},
+	}
+}
+
+func (s *Server) registerPipelinesRoutes() {
+	s.router.handle(http.MethodGet, "{projectId}/_apis/build/generalsettings", s.getPipelineGeneralSettings)
+	s.router.handle(http.MethodPatch, "{projectId}/_apis/build/generalsettings", s.updatePipelineGeneralSettings)
+	s.router.handle(http.MethodGet, "{projectId}/_apis/build/retention", s.getPipelineRetentionSettings)
+	s.router.handle(http.MethodPatch, "{projectId}/_apis/build/retention", s.updatePipelineRetentionSettings)
+	s.router.handle(http.MethodPost, "{projectId}/_apis/distributedtask/environments", s.createEnvironment)
+	s.router.handle(http.MethodGet, "{projectId}/_apis/distributedtask/environments/{environmentId}", s.getEnvironment)
+	s.router.handle(http.MethodPatch, "{projectId}/_apis/distributedtask/environments/{environmentId}", s.updateEnvironment)
+	s.router.handle(http.MethodDelete, "{projectId}/_apis/distributedtask/environments/{environmentId}", s.deleteEnvironment)
+	s.router.handle(http.MethodPost, "{projectId}/_apis/distributedtask/environments/{environmentId}/providers/kubernetes", s.createEnvironmentKubernetes)
+	s.router.handle(http.MethodGet, "{projectId}/_apis/distributedtask/environments/{environmentId}/providers/kubernetes/{resourceId}", s.getEnvironmentKubernetes)
+	s.router.handle(http.MethodDelete, "{projectId}/_apis/distributedtask/environments/{environmentId}/providers/kubernetes/{resourceId}", s.deleteEnvironmentKubernetes)
+	s.router.handle(http.MethodPost, "{projectId}/_apis/distributedtask/queues", s.createAgentQueue)
+	s.router.handle(http.MethodGet, "{projectId}/_apis/distributedtask/queues/{queueId}", s.getAgentQueue)
+	s.router.handle(http.MethodDelete, "{projectId}/_apis/distributedtask/queues/{queueId}", s.deleteAgentQueue)
+	s.router.handle(http.MethodGet, "{projectId}/_apis/pipelines/pipelinepermissions/{resourceType}/{resourceId}", s.getPipelinePermissions)
+	s.router.handle(http.MethodPatch, "{projectId}/_apis/pipelines/pipelinepermissions/{resourceType}/{resourceId}", s.updatePipelinePermissions)
+	s.router.handle(http.MethodPost, "_apis/distributedtask/pools", s.createAgentPool)
+	s.router.handle(http.MethodGet, "_apis/distributedtask/pools/{poolId}", s.getAgentPool)
+	s.router.handle(http.MethodPatch, "_apis/distributedtask/pools/{poolId}", s.updateAgentPool)
+	s.router.handle(http.MethodDelete, "_apis/distributedtask/pools/{poolId}", s.deleteAgentPool)
+}
+
+// Private Methods
+
+func (s *Server) createAgentPool(req *request) (any, error) {
+	var body pipelines.TaskAgentPool
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The name of the agent pool is required.")
+	}
+	for _, pool := range s.agentPools {
+		if strings.EqualFold(*pool.Name, *body.Name) {
+			return nil, conflict("Agent pool %s already exists.", *body.Name)
+		}
+	}
+
+	pool := &pipelines.TaskAgentPool{
+		AutoProvision: utils.IfThenElse[*bool](body.AutoProvision != nil, body.AutoProvision, utils.Bool(false)),
+		AutoSize:      utils.Bool(true),
+		// Like the REST API, the value sent on creation is ignored until the pool is updated
+		AutoUpdate: utils.Bool(true),
+		CreatedOn:  &core.Time{Time: time.Now().UTC()},
+		Id:         utils.Int(s.nextId()),
+		IsHosted:   utils.Bool(false),
+		IsLegacy:   utils.Bool(false),
+		Name:       body.Name,
+		PoolType:   utils.IfThenElse[*string](body.PoolType != nil, body.PoolType, utils.String("automation")),
+		Scope:      utils.UUID(uuid.NewString()),
+		Size:       utils.Int(0),
+		TargetSize: utils.Int(0),
+	}
+	s.agentPools[*pool.Id] = pool
+	return pool, nil
+}
+
+func (s *Server) createAgentQueue(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.TaskAgentQueue
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Pool == nil || body.Pool.Id == nil {
+		return nil, badRequest("The agent pool of the queue is required.")
+	}
+
+	pool, ok := s.agentPools[*body.Pool.Id]
+	if !ok {
+		return nil, notFound("Agent pool %d not found.", *body.Pool.Id)
+	}
+
+	name := utils.IfThenElse[*string](body.Name != nil && *body.Name != "", body.Name, pool.Name)
+	for _, queue := range s.agentQueues {
+		if queue.projectId == *p.value.Id && strings.EqualFold(*queue.value.Name, *name) {
+			return nil, conflict("Agent queue %s already exists.", *name)
+		}
+	}
+
+	queue := &agentQueue{
+		projectId: *p.value.Id,
+		value: &pipelines.TaskAgentQueue{
+			Id:        utils.Int(s.nextId()),
+			Name:      name,
+			Pool:      &pipelines.TaskAgentPoolReference{Id: pool.Id, IsHosted: pool.IsHosted, IsLegacy: pool.IsLegacy, Name: pool.Name, PoolType: pool.PoolType, Scope: pool.Scope, Size: pool.Size},
+			ProjectId: p.value.Id,
+		},
+	}
+	s.agentQueues[*queue.value.Id] = queue
+
+	if strings.EqualFold(req.query("authorizePipelines"), "true") {
+		s.pipelinePermissions[pipelinePermissionsKey(*p.value.Id, pipelines.PipelinePermissionsResourceTypeQueue, strconv.Itoa(*queue.value.Id))] = &pipelines.ResourcePipelinePermissions{
+			AllPipelines: &pipelines.Permission{Authorized: utils.Bool(true), AuthorizedOn: &core.Time{Time: time.Now().UTC()}},
+		}
+	}
+	return queue.value, nil
+}
+
+func (s *Server) createEnvironment(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.CreateOrUpdateEnvironmentArgs
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(body.Name) == "" {
+		return nil, badRequest("The name of the environment is required.")
+	}
+	if s.findEnvironment(*p.value.Id, body.Name) != nil {
+		return nil, conflict("Environment with name %s already exists.", body.Name)
+	}
+
+	now := &core.Time{Time: time.Now().UTC()}
+	env := &environment{
+		projectId: *p.value.Id,
+		value: &pipelines.EnvironmentInstance{
+			CreatedOn:      now,
+			Description:    utils.String(body.Description),
+			Id:             utils.Int(s.nextId()),
+			LastModifiedOn: now,
+			Name:           utils.String(body.Name),
+			Project:        &core.ProjectReference{Id: p.value.Id, Name: p.value.Name},
+		},
+	}
+	s.environments[*env.value.Id] = env
+	return env.value, nil
+}
+
+func (s *Server) createEnvironmentKubernetes(req *request) (any, error) {
+	env, err := s.getEnvironmentParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.EnvironmentResourceKubernetes
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || body.Namespace == nil || body.ServiceEndpointId == nil {
+		return nil, badRequest("The name, namespace and service endpoint of the resource are required.")
+	}
+	if serviceEndpointId, err := uuid.Parse(*body.ServiceEndpointId); err != nil || s.serviceEndpoints[serviceEndpointId] == nil {
+		return nil, badRequest("The service endpoint '%s' does not exist.", *body.ServiceEndpointId)
+	}
+
+	now := &core.Time{Time: time.Now().UTC()}
+	resource := &environmentKubernetes{
+		environmentId: *env.value.Id,
+		value: &pipelines.EnvironmentResourceKubernetes{
+			ClusterName:          body.ClusterName,
+			CreatedOn:            now,
+			EnvironmentReference: &pipelines.EnvironmentReference{Id: env.value.Id, Name: env.value.Name},
+			Id:                   utils.Int(s.nextId()),
+			LastModifiedOn:       now,
+			Name:                 body.Name,
+			Namespace:            body.Namespace,
+			ServiceEndpointId:    body.ServiceEndpointId,
+			Tags:                 utils.IfThenElse[*[]string](body.Tags != nil, body.Tags, &[]string{}),
+			Type:                 utils.String(environmentResourceTypeKubernetes),
+		},
+	}
+	s.environmentK8s[*resource.value.Id] = resource
+	return resource.value, nil
+}
+
+func (s *Server) deleteAgentPool(req *request) (any, error) {
+	pool, err := s.getAgentPoolParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, queue := range s.agentQueues {
+		if *queue.value.Pool.Id == *pool.Id {
+			delete(s.agentQueues, id)
+		}
+	}
+	delete(s.agentPools, *pool.Id)
+	return nil, nil
+}
+
+func (s *Server) deleteAgentQueue(req *request) (any, error) {
+	queue, err := s.getAgentQueueParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.agentQueues, *queue.value.Id)
+	return nil, nil
+}
+
+func (s *Server) deleteEnvironment(req *request) (any, error) {
+	env, err := s.getEnvironmentParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, resource := range s.environmentK8s {
+		if resource.environmentId == *env.value.Id {
+			delete(s.environmentK8s, id)
+		}
+	}
+	delete(s.environments, *env.value.Id)
+	return nil, nil
+}
+
+func (s *Server) deleteEnvironmentKubernetes(req *request) (any, error) {
+	resource, err := s.getEnvironmentKubernetesParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.environmentK8s, *resource.value.Id)
+	return nil, nil
+}
+
+func (s *Server) findEnvironment(projectId uuid.UUID, name string) *environment {
+	for _, env := range s.environments {
+		if env.projectId == projectId && strings.EqualFold(*env.value.Name, name) {
+			return env
+		}
+	}
+	return nil
+}
+
+func (s *Server) getAgentPool(req *request) (any, error) {
+	return s.getAgentPoolParam(req)
+}
+
+func (s *Server) getAgentPoolParam(req *request) (*pipelines.TaskAgentPool, error) {
+	id, _ := strconv.Atoi(req.params["poolId"])
+	pool, ok := s.agentPools[id]
+	if !ok {
+		return nil, notFound("Agent pool %s not found.", req.params["poolId"])
+	}
+	return pool, nil
+}
+
+func (s *Server) getAgentQueue(req *request) (any, error) {
+	queue, err := s.getAgentQueueParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return queue.value, nil
+}
+
+func (s *Server) getAgentQueueParam(req *request) (*agentQueue, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := strconv.Atoi(req.params["queueId"])
+	queue, ok := s.agentQueues[id]
+	if !ok || queue.projectId != *p.value.Id {
+		return nil, notFound("Agent queue %s not found.", req.params["queueId"])
+	}
+	return queue, nil
+}
+
+func (s *Server) getEnvironment(req *request) (any, error) {
+	env, err := s.getEnvironmentParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *env.value
+	var resources []pipelines.EnvironmentResourceReference
+	for _, resource := range s.environmentK8s {
+		if resource.environmentId == *env.value.Id {
+			resourceType := pipelines.EnvironmentResourceType(*resource.value.Type)
+			resources = append(resources, pipelines.EnvironmentResourceReference{Id: resource.value.Id, Name: resource.value.Name, Tags: resource.value.Tags, Type: &resourceType})
+		}
+	}
+	result.Resources = &resources
+	return &result, nil
+}
+
+func (s *Server) getEnvironmentKubernetes(req *request) (any, error) {
+	resource, err := s.getEnvironmentKubernetesParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return resource.value, nil
+}
+
+func (s *Server) getEnvironmentKubernetesParam(req *request) (*environmentKubernetes, error) {
+	env, err := s.getEnvironmentParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := strconv.Atoi(req.params["resourceId"])
+	resource, ok := s.environmentK8s[id]
+	if !ok || resource.environmentId != *env.value.Id {
+		return nil, notFound("Environment resource %s not found.", req.params["resourceId"])
+	}
+	return resource, nil
+}
+
+func (s *Server) getEnvironmentParam(req *request) (*environment, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := strconv.Atoi(req.params["environmentId"])
+	env, ok := s.environments[id]
+	if !ok || env.projectId != *p.value.Id {
+		return nil, notFound("Environment %s not found.", req.params["environmentId"])
+	}
+	return env, nil
+}
+
+func (s *Server) getPipelineGeneralSettings(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return p.generalSettings, nil
+}
+
+func (s *Server) getPipelinePermissions(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceType := req.params["resourceType"]
+	resourceId := req.params["resourceId"]
+	permissions, ok := s.pipelinePermissions[pipelinePermissionsKey(*p.value.Id, resourceType, resourceId)]
+	if !ok {
+		permissions = &pipelines.ResourcePipelinePermissions{}
+	}
+
+	result := *permissions
+	result.Pipelines = utils.IfThenElse[*[]pipelines.PipelinePermission](result.Pipelines != nil, result.Pipelines, &[]pipelines.PipelinePermission{})
+	result.Resource = &pipelines.Resource{Id: &resourceId, Type: &resourceType}
+	return &result, nil
+}
+
+func (s *Server) getPipelineRetentionSettings(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return p.retentionSettings, nil
+}
+
+func (s *Server) updateAgentPool(req *request) (any, error) {
+	pool, err := s.getAgentPoolParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.TaskAgentPool
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.AutoProvision != nil {
+		pool.AutoProvision = body.AutoProvision
+	}
+	if body.AutoUpdate != nil {
+		pool.AutoUpdate = body.AutoUpdate
+	}
+	if body.Name != nil && *body.Name != "" {
+		pool.Name = body.Name
+	}
+	return pool, nil
+}
+
+func (s *Server) updateEnvironment(req *request) (any, error) {
+	env, err := s.getEnvironmentParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.CreateOrUpdateEnvironmentArgs
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(body.Name) == "" {
+		return nil, badRequest("The name of the environment is required.")
+	}
+	if other := s.findEnvironment(env.projectId, body.Name); other != nil && other != env {
+		return nil, conflict("Environment with name %s already exists.", body.Name)
+	}
+
+	env.value.Description = utils.String(body.Description)
+	env.value.LastModifiedOn = &core.Time{Time: time.Now().UTC()}
+	env.value.Name = utils.String(body.Name)
+	return env.value, nil
+}
+
+func (s *Server) updatePipelineGeneralSettings(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.PipelineGeneralSettings
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	settings := p.generalSettings
+	for _, setting := range []struct{ current, value **bool }{
+		{&settings.DisableClassicPipelineCreation, &body.DisableClassicPipelineCreation},
+		{&settings.EnforceJobAuthScope, &body.EnforceJobAuthScope},
+		{&settings.EnforceJobAuthScopeForReleases, &body.EnforceJobAuthScopeForReleases},
+		{&settings.EnforceReferencedRepoScopedToken, &body.EnforceReferencedRepoScopedToken},
+		{&settings.EnforceSettableVar, &body.EnforceSettableVar},
+		{&settings.PublishPipelineMetadata, &body.PublishPipelineMetadata},
+		{&settings.StatusBadgesArePrivate, &body.StatusBadgesArePrivate},
+	} {
+		if *setting.value != nil {
+			*setting.current = *setting.value
+		}
+	}
+	return settings, nil
+}
+
+func (s *Server) updatePipelinePermissions(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.ResourcePipelinePermissions
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	key := pipelinePermissionsKey(*p.value.Id, req.params["resourceType"], req.params["resourceId"])
+	permissions, ok := s.pipelinePermissions[key]
+	if !ok {
+		permissions = &pipelines.ResourcePipelinePermissions{}
+		s.pipelinePermissions[key] = permissions
+	}
+	if body.AllPipelines != nil && body.AllPipelines.Authorized != nil {
+		permissions.AllPipelines = &pipelines.Permission{Authorized: body.AllPipelines.Authorized, AuthorizedOn: &core.Time{Time: time.Now().UTC()}}
+	}
+	return s.getPipelinePermissions(req)
+}
+
+func (s *Server) updatePipelineRetentionSettings(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body pipelines.UpdatePipelineRetentionSettings
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	settings := p.retentionSettings
+	for _, setting := range []struct{ current, value *pipelines.RetentionSetting }{
+		{settings.PurgeArtifacts, body.PurgeArtifacts},
+		{settings.PurgePullRequestRuns, body.PurgePullRequestRuns},
+		{settings.PurgeRuns, body.PurgeRuns},
+		{settings.RetainRunsPerProtectedBranch, body.RetainRunsPerProtectedBranch},
+	} {
+		if setting.value == nil || setting.value.Value == nil {
+			continue
+		}
+		if *setting.value.Value < *setting.current.Min || *setting.value.Value > *setting.current.Max {
+			return nil, badRequest("The retention value %d must be between %d and %d.", *setting.value.Value, *setting.current.Min, *setting.current.Max)
+		}
+		setting.current.Value = setting.value.Value
+	}
+	return settings, nil
+}
+
+func pipelinePermissionsKey(projectId uuid.UUID, resourceType string, resourceId string) string {
+	return strings.ToLower(projectId.String() + "/" + resourceType + "/" + resourceId)
+}
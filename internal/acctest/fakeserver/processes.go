@@ -0,0 +1,1383 @@
+package fakeserver
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"strings"
+)
+
+const (
+	controlTypeField     = "FieldControl"
+	processTypeInherited = "inherited"
+	processTypeSystem    = "system"
+)
+
+// inheritedProcess is a process inheriting from a system process, with the work item types it overrides or adds. Its
+// default flag is kept by the matching core process, which the projects are created from.
+type inheritedProcess struct {
+	value         *processes.ProcessInfo
+	workItemTypes []*processWorkItemType
+}
+
+// processWorkItemType is a work item type of a process, with its fields, layout, rules and states. The work item
+// types of the system processes are built from templates on each request, and copied when a process overrides them.
+type processWorkItemType struct {
+	defaults map[string]processes.ProcessWorkItemTypeField // Inherited settings of the fields, restored on removal
+	fields   []*processes.ProcessWorkItemTypeField
+	layout   *processes.FormLayout
+	rules    []*processes.ProcessRule
+	states   []*processes.WorkItemStateResultModel
+	value    *processes.ProcessWorkItemType
+}
+
+func (s *Server) registerProcessesRoutes() {
+	s.router.handle(http.MethodGet, "_apis/work/processes", s.getWorkProcesses)
+	s.router.handle(http.MethodPost, "_apis/work/processes", s.createWorkProcess)
+	s.router.handle(http.MethodGet, "_apis/work/processes/lists", s.getPicklists)
+	s.router.handle(http.MethodPost, "_apis/work/processes/lists", s.createPicklist)
+	s.router.handle(http.MethodGet, "_apis/work/processes/lists/{listId}", s.getPicklist)
+	s.router.handle(http.MethodPut, "_apis/work/processes/lists/{listId}", s.updatePicklist)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/lists/{listId}", s.deletePicklist)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}", s.getWorkProcess)
+	s.router.handle(http.MethodPatch, "_apis/work/processes/{processId}", s.updateWorkProcess)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}", s.deleteWorkProcess)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes", s.getWorkItemTypes)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{processId}/workitemtypes", s.createWorkItemType)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes/{witRefName}", s.getWorkItemType)
+	s.router.handle(http.MethodPatch, "_apis/work/processes/{processId}/workitemtypes/{witRefName}", s.updateWorkItemType)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}/workitemtypes/{witRefName}", s.deleteWorkItemType)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/fields", s.addWorkItemTypeField)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/fields/{fieldRefName}", s.getWorkItemTypeField)
+	s.router.handle(http.MethodPatch, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/fields/{fieldRefName}", s.updateWorkItemTypeField)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/fields/{fieldRefName}", s.removeWorkItemTypeField)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout", s.getLayout)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/groups/{groupId}/controls", s.addLayoutControl)
+	s.router.handle(http.MethodPatch, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/groups/{groupId}/controls/{controlId}", s.updateLayoutControl)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/groups/{groupId}/controls/{controlId}", s.removeLayoutControl)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/pages", s.addLayoutPage)
+	s.router.handle(http.MethodPatch, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/pages", s.updateLayoutPage)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/pages/{pageId}", s.removeLayoutPage)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/pages/{pageId}/sections/{sectionId}/groups", s.addLayoutGroup)
+	s.router.handle(http.MethodPatch, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/pages/{pageId}/sections/{sectionId}/groups/{groupId}", s.updateLayoutGroup)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/layout/pages/{pageId}/sections/{sectionId}/groups/{groupId}", s.removeLayoutGroup)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/rules", s.getRules)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/rules", s.createRule)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/rules/{ruleId}", s.getRule)
+	s.router.handle(http.MethodPut, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/rules/{ruleId}", s.updateRule)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/rules/{ruleId}", s.deleteRule)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/states", s.getStates)
+	s.router.handle(http.MethodPost, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/states", s.createState)
+	s.router.handle(http.MethodGet, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/states/{stateId}", s.getState)
+	s.router.handle(http.MethodPatch, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/states/{stateId}", s.updateState)
+	s.router.handle(http.MethodDelete, "_apis/work/processes/{processId}/workitemtypes/{witRefName}/states/{stateId}", s.deleteState)
+}
+
+// Private Methods
+
+func (s *Server) addLayoutControl(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, group := findLayoutGroup(wit.layout, req.params["groupId"])
+	if group == nil {
+		return nil, notFound("VS402835: The group '%s' does not exist.", req.params["groupId"])
+	}
+
+	var body processes.Control
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Id == nil || *body.Id == "" {
+		return nil, badRequest("The ID of the control is required.")
+	}
+	field := wit.findField(*body.Id)
+	if field == nil {
+		return nil, badRequest("VS402836: The field '%s' must be added to the work item type '%s' before being added to its layout.", *body.Id, *wit.value.Name)
+	}
+	if findLayoutControl(wit.layout, *body.Id) != nil {
+		return nil, conflict("VS402837: The field '%s' is already in the layout of the work item type '%s'.", *body.Id, *wit.value.Name)
+	}
+
+	control := &processes.Control{
+		ControlType: utils.String(controlTypeField),
+		Id:          field.ReferenceName,
+		Inherited:   utils.Bool(false),
+		Label:       utils.IfThenElse[*string](body.Label != nil && *body.Label != "", body.Label, field.Name),
+		Order:       utils.IfThenElse[*int](body.Order != nil, body.Order, utils.Int(len(*group.Controls))),
+		ReadOnly:    utils.Bool(false),
+		Visible:     utils.IfThenElse[*bool](body.Visible != nil, body.Visible, utils.Bool(true)),
+	}
+	*group.Controls = append(*group.Controls, *control)
+	return control, nil
+}
+
+func (s *Server) addLayoutGroup(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	section := findLayoutSection(wit.layout, req.params["pageId"], req.params["sectionId"])
+	if section == nil {
+		return nil, notFound("VS402835: The section '%s' of the page '%s' does not exist.", req.params["sectionId"], req.params["pageId"])
+	}
+
+	var body processes.Group
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Label == nil || strings.TrimSpace(*body.Label) == "" {
+		return nil, badRequest("The label of the group is required.")
+	}
+
+	group := &processes.Group{
+		Controls:  &[]processes.Control{},
+		Id:        utils.String(uuid.NewString()),
+		Inherited: utils.Bool(false),
+		Label:     body.Label,
+		Order:     utils.IfThenElse[*int](body.Order != nil, body.Order, utils.Int(len(*section.Groups))),
+		Visible:   utils.IfThenElse[*bool](body.Visible != nil, body.Visible, utils.Bool(true)),
+	}
+	*section.Groups = append(*section.Groups, *group)
+	return group, nil
+}
+
+func (s *Server) addLayoutPage(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.Page
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Label == nil || strings.TrimSpace(*body.Label) == "" {
+		return nil, badRequest("The label of the page is required.")
+	}
+
+	var sections []processes.Section
+	for i := 1; i <= 3; i++ {
+		sections = append(sections, processes.Section{Groups: &[]processes.Group{}, Id: utils.String(fmt.Sprintf("Section%d", i))})
+	}
+	page := &processes.Page{
+		Id:        utils.String(uuid.NewString()),
+		Inherited: utils.Bool(false),
+		Label:     body.Label,
+		Locked:    utils.Bool(false),
+		Order:     utils.IfThenElse[*int](body.Order != nil, body.Order, utils.Int(len(*wit.layout.Pages))),
+		PageType:  utils.String(processes.PageTypeCustom),
+		Sections:  &sections,
+		Visible:   utils.IfThenElse[*bool](body.Visible != nil, body.Visible, utils.Bool(true)),
+	}
+	*wit.layout.Pages = append(*wit.layout.Pages, *page)
+	return page, nil
+}
+
+// addWorkItemTypeField adds a field of the organization to a work item type, or customizes one of its inherited
+// fields.
+func (s *Server) addWorkItemTypeField(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.ProcessWorkItemTypeFieldRequest
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.ReferenceName == nil || *body.ReferenceName == "" {
+		return nil, badRequest("The reference name of the field is required.")
+	}
+
+	if field := wit.findField(*body.ReferenceName); field != nil {
+		if _, ok := wit.defaults[strings.ToLower(*field.ReferenceName)]; !ok {
+			return nil, conflict("VS402804: The field '%s' has already been added to the work item type '%s'.", *field.ReferenceName, *wit.value.Name)
+		}
+		setWorkItemTypeField(field, &body)
+		field.Customization = utils.String(processes.CustomizationTypeInherited)
+		return field, nil
+	}
+
+	orgField := s.findField(*body.ReferenceName)
+	if orgField == nil {
+		return nil, notFound("TF51535: Cannot find field %s.", *body.ReferenceName)
+	}
+
+	field := &processes.ProcessWorkItemTypeField{
+		AllowGroups:   utils.Bool(false),
+		Customization: utils.String(processes.CustomizationTypeCustom),
+		Description:   orgField.Description,
+		Name:          orgField.Name,
+		ReadOnly:      utils.Bool(false),
+		ReferenceName: orgField.ReferenceName,
+		Required:      utils.Bool(false),
+		Type:          orgField.Type,
+		Url:           utils.String(fmt.Sprintf("%s/_apis/work/processes/%s/workitemtypes/%s/fields/%s", s.URL, req.params["processId"], *wit.value.ReferenceName, *orgField.ReferenceName)),
+	}
+	setWorkItemTypeField(field, &body)
+	wit.fields = append(wit.fields, field)
+	return field, nil
+}
+
+func (s *Server) createPicklist(req *request) (any, error) {
+	var body processes.PickList
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The name of the picklist is required.")
+	}
+	if body.Items == nil || len(*body.Items) == 0 {
+		return nil, badRequest("VS402850: The picklist '%s' must have at least one item.", *body.Name)
+	}
+
+	id := uuid.New()
+	picklist := &processes.PickList{
+		Id:          &id,
+		IsSuggested: utils.IfThenElse[*bool](body.IsSuggested != nil, body.IsSuggested, utils.Bool(false)),
+		Items:       body.Items,
+		Name:        body.Name,
+		Type:        utils.IfThenElse[*string](body.Type != nil, body.Type, utils.String(processes.PicklistTypeString)),
+		Url:         utils.String(s.URL + "/_apis/work/processes/lists/" + id.String()),
+	}
+	s.picklists[id] = picklist
+	return picklist, nil
+}
+
+func (s *Server) createRule(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.ProcessRuleRequest
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if err = wit.validateRule(&body, nil); err != nil {
+		return nil, err
+	}
+
+	id := uuid.New()
+	rule := &processes.ProcessRule{
+		CustomizationType: utils.String(processes.CustomizationTypeCustom),
+		Id:                &id,
+		IsSystem:          utils.Bool(false),
+		Url:               utils.String(fmt.Sprintf("%s/_apis/work/processes/%s/workitemtypes/%s/rules/%s", s.URL, req.params["processId"], *wit.value.ReferenceName, id)),
+	}
+	setRule(rule, &body)
+	wit.rules = append(wit.rules, rule)
+	return rule, nil
+}
+
+func (s *Server) createState(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.WorkItemStateInputModel
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The name of the state is required.")
+	}
+	if !isStateCategory(body.StateCategory) {
+		return nil, badRequest("VS402820: The state category '%s' is not valid.", *utils.IfThenElse[*string](body.StateCategory != nil, body.StateCategory, utils.EmptyString))
+	}
+	if wit.findState(*body.Name) != nil {
+		return nil, conflict("VS402821: The state '%s' already exists in the work item type '%s'.", *body.Name, *wit.value.Name)
+	}
+
+	id := uuid.New()
+	state := &processes.WorkItemStateResultModel{
+		Color:             utils.IfThenElse[*string](body.Color != nil, body.Color, utils.String("b2b2b2")),
+		CustomizationType: utils.String(processes.CustomizationTypeCustom),
+		Hidden:            utils.Bool(false),
+		Id:                &id,
+		Name:              body.Name,
+		Order:             utils.IfThenElse[*int](body.Order != nil, body.Order, utils.Int(len(wit.states)+1)),
+		StateCategory:     body.StateCategory,
+		Url:               utils.String(fmt.Sprintf("%s/_apis/work/processes/%s/workitemtypes/%s/states/%s", s.URL, req.params["processId"], *wit.value.ReferenceName, id)),
+	}
+	wit.states = append(wit.states, state)
+	return state, nil
+}
+
+// createWorkItemType overrides a work item type of the parent process when the request inherits from it, or adds a
+// custom work item type to the process otherwise.
+func (s *Server) createWorkItemType(req *request) (any, error) {
+	ip, err := s.getInheritedProcessParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.CreateProcessWorkItemTypeRequest
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	var wit *processWorkItemType
+	if body.InheritsFrom != nil && *body.InheritsFrom != "" {
+		wit = s.findSystemWorkItemType(*ip.value.ParentProcessTypeId, *body.InheritsFrom)
+		if wit == nil {
+			return nil, notFound("VS402805: The work item type '%s' does not exist in the parent process.", *body.InheritsFrom)
+		}
+		for _, w := range ip.workItemTypes {
+			if w.value.Inherits != nil && strings.EqualFold(*w.value.Inherits, *body.InheritsFrom) {
+				return nil, conflict("VS402806: The work item type '%s' has already been overridden.", *body.InheritsFrom)
+			}
+		}
+		wit.markInherited()
+		wit.value.Class = utils.String("derived")
+		wit.value.Customization = utils.String(processes.CustomizationTypeInherited)
+		wit.value.Inherits = wit.value.ReferenceName
+	} else {
+		if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+			return nil, badRequest("The name of the work item type is required.")
+		}
+		for _, w := range s.workItemTypes(ip) {
+			if strings.EqualFold(*w.value.Name, *body.Name) {
+				return nil, conflict("VS402807: The work item type '%s' already exists.", *body.Name)
+			}
+		}
+		wit = newWorkItemType(*body.Name, processes.CustomizationTypeCustom)
+		wit.states = newStates(wit, []string{"New", "Active", "Closed"}, []string{processes.StateCategoryProposed, processes.StateCategoryInProgress, processes.StateCategoryCompleted})
+	}
+
+	wit.value.ReferenceName = utils.String(*ip.value.ReferenceName + "." + strings.ReplaceAll(*wit.value.Name, " ", ""))
+	wit.value.Url = utils.String(fmt.Sprintf("%s/_apis/work/processes/%s/workitemtypes/%s", s.URL, ip.value.TypeId, *wit.value.ReferenceName))
+	setWorkItemType(wit.value, body.Color, body.Description, body.Icon, body.IsDisabled)
+	ip.workItemTypes = append(ip.workItemTypes, wit)
+	return wit.value, nil
+}
+
+// createWorkProcess creates a process inheriting from a system process, which is also listed by the core processes.
+func (s *Server) createWorkProcess(req *request) (any, error) {
+	var body processes.CreateProcessModel
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The name of the process is required.")
+	}
+	if body.ParentProcessTypeId == nil {
+		return nil, badRequest("The parent process type ID is required.")
+	}
+
+	parent := s.findProcess(body.ParentProcessTypeId.String())
+	if parent == nil {
+		return nil, notFound("VS402362: The process template '%s' does not exist.", body.ParentProcessTypeId)
+	}
+	if *parent.Type != processTypeSystem {
+		return nil, badRequest("VS402801: A process can only inherit from a system process, '%s' is not.", *parent.Name)
+	}
+	referenceName := utils.IfThenElse[*string](body.ReferenceName != nil && *body.ReferenceName != "", body.ReferenceName, utils.String("Inherited."+strings.ReplaceAll(*body.Name, " ", "")))
+	for _, process := range s.processes {
+		if strings.EqualFold(*process.Name, *body.Name) {
+			return nil, conflict("VS402802: The process '%s' already exists.", *body.Name)
+		}
+		if ip, ok := s.inheritedProcesses[*process.Id]; ok && strings.EqualFold(*ip.value.ReferenceName, *referenceName) {
+			return nil, conflict("VS402803: The reference name '%s' is already used by the process '%s'.", *referenceName, *process.Name)
+		}
+	}
+
+	id := uuid.New()
+	ip := &inheritedProcess{
+		value: &processes.ProcessInfo{
+			CustomizationType:   utils.String(processes.CustomizationTypeInherited),
+			Description:         utils.IfThenElse[*string](body.Description != nil, body.Description, utils.EmptyString),
+			IsEnabled:           utils.Bool(true),
+			Name:                body.Name,
+			ParentProcessTypeId: body.ParentProcessTypeId,
+			ReferenceName:       referenceName,
+			TypeId:              &id,
+		},
+	}
+	s.inheritedProcesses[id] = ip
+	s.processes = append(s.processes, core.Process{
+		Description: ip.value.Description,
+		Id:          &id,
+		IsDefault:   utils.Bool(false),
+		Name:        body.Name,
+		Type:        utils.String(processTypeInherited),
+		Url:         utils.String(s.URL + "/_apis/process/processes/" + id.String()),
+	})
+	return s.processInfo(s.findProcess(id.String())), nil
+}
+
+func (s *Server) deletePicklist(req *request) (any, error) {
+	picklist, err := s.getPicklistParam(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range s.fields {
+		if field.PicklistId != nil && *field.PicklistId == *picklist.Id {
+			return nil, badRequest("VS402851: The picklist '%s' is used by the field '%s'.", *picklist.Name, *field.Name)
+		}
+	}
+
+	delete(s.picklists, *picklist.Id)
+	return nil, nil
+}
+
+func (s *Server) deleteRule(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	i, _ := wit.findRule(req.params["ruleId"])
+	if i < 0 {
+		return nil, notFound("VS402825: The rule '%s' does not exist.", req.params["ruleId"])
+	}
+	wit.rules = append(wit.rules[:i], wit.rules[i+1:]...)
+	return nil, nil
+}
+
+func (s *Server) deleteState(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, state := range wit.states {
+		if strings.EqualFold(state.Id.String(), req.params["stateId"]) {
+			if *state.CustomizationType != processes.CustomizationTypeCustom {
+				return nil, badRequest("VS402822: The inherited state '%s' cannot be deleted, it can only be hidden.", *state.Name)
+			}
+			wit.states = append(wit.states[:i], wit.states[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, notFound("VS402823: The state '%s' does not exist.", req.params["stateId"])
+}
+
+// deleteWorkItemType deletes a custom work item type, or removes the customizations of an overridden one.
+func (s *Server) deleteWorkItemType(req *request) (any, error) {
+	ip, err := s.getInheritedProcessParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, wit := range ip.workItemTypes {
+		if strings.EqualFold(*wit.value.ReferenceName, req.params["witRefName"]) {
+			ip.workItemTypes = append(ip.workItemTypes[:i], ip.workItemTypes[i+1:]...)
+			return nil, nil
+		}
+	}
+	if s.findSystemWorkItemType(*ip.value.ParentProcessTypeId, req.params["witRefName"]) != nil {
+		return nil, badRequest("VS402808: The system work item type '%s' cannot be deleted.", req.params["witRefName"])
+	}
+	return nil, notFound("VS402805: The work item type '%s' does not exist.", req.params["witRefName"])
+}
+
+func (s *Server) deleteWorkProcess(req *request) (any, error) {
+	ip, err := s.getInheritedProcessParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	process := s.findProcess(ip.value.TypeId.String())
+	if *process.IsDefault {
+		return nil, badRequest("VS402809: The default process '%s' cannot be deleted.", *process.Name)
+	}
+	for _, p := range s.projects {
+		if (*p.value.Capabilities)[core.CapabilitiesProcessTemplate][core.CapabilitiesProcessTemplateTypeId] == ip.value.TypeId.String() {
+			return nil, badRequest("VS402810: The process '%s' is used by the project '%s'.", *process.Name, *p.value.Name)
+		}
+	}
+
+	delete(s.inheritedProcesses, *ip.value.TypeId)
+	for i := range s.processes {
+		if *s.processes[i].Id == *ip.value.TypeId {
+			s.processes = append(s.processes[:i], s.processes[i+1:]...)
+			break
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) findProcessByName(name string) *core.Process {
+	for i := range s.processes {
+		if strings.EqualFold(*s.processes[i].Name, name) {
+			return &s.processes[i]
+		}
+	}
+	return nil
+}
+
+// findSystemWorkItemType returns a new copy of a work item type of a system process.
+func (s *Server) findSystemWorkItemType(processId uuid.UUID, witRefName string) *processWorkItemType {
+	for _, wit := range s.systemWorkItemTypes(processId) {
+		if strings.EqualFold(*wit.value.ReferenceName, witRefName) {
+			return wit
+		}
+	}
+	return nil
+}
+
+// getCustomizedWorkItemTypeParam returns the work item type of the route, which must belong to an inherited process
+// and be either overridden or custom to be changed.
+func (s *Server) getCustomizedWorkItemTypeParam(req *request) (*processWorkItemType, error) {
+	ip, err := s.getInheritedProcessParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wit := range ip.workItemTypes {
+		if strings.EqualFold(*wit.value.ReferenceName, req.params["witRefName"]) {
+			return wit, nil
+		}
+	}
+	if s.findSystemWorkItemType(*ip.value.ParentProcessTypeId, req.params["witRefName"]) != nil {
+		return nil, badRequest("VS402811: The work item type '%s' must be overridden by the process before being customized.", req.params["witRefName"])
+	}
+	return nil, notFound("VS402805: The work item type '%s' does not exist.", req.params["witRefName"])
+}
+
+// getInheritedProcessParam returns the inherited process of the route, the system processes being read-only.
+func (s *Server) getInheritedProcessParam(req *request) (*inheritedProcess, error) {
+	process := s.findProcess(req.params["processId"])
+	if process == nil {
+		return nil, notFound("VS402362: The process template '%s' does not exist.", req.params["processId"])
+	}
+	ip, ok := s.inheritedProcesses[*process.Id]
+	if !ok {
+		return nil, badRequest("VS402812: The system process '%s' cannot be customized.", *process.Name)
+	}
+	return ip, nil
+}
+
+func (s *Server) getLayout(req *request) (any, error) {
+	wit, err := s.getWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return wit.layout, nil
+}
+
+func (s *Server) getPicklist(req *request) (any, error) {
+	return s.getPicklistParam(req)
+}
+
+func (s *Server) getPicklistParam(req *request) (*processes.PickList, error) {
+	id, err := uuid.Parse(req.params["listId"])
+	if err == nil {
+		if picklist, ok := s.picklists[id]; ok {
+			return picklist, nil
+		}
+	}
+	return nil, notFound("VS402852: The picklist '%s' does not exist.", req.params["listId"])
+}
+
+func (s *Server) getPicklists(_ *request) (any, error) {
+	var picklists []processes.PickList
+	for _, picklist := range s.picklists {
+		picklists = append(picklists, processes.PickList{Id: picklist.Id, IsSuggested: picklist.IsSuggested, Name: picklist.Name, Type: picklist.Type, Url: picklist.Url})
+	}
+	return collection(picklists), nil
+}
+
+func (s *Server) getRule(req *request) (any, error) {
+	wit, err := s.getWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rule := wit.findRule(req.params["ruleId"])
+	if rule == nil {
+		return nil, notFound("VS402825: The rule '%s' does not exist.", req.params["ruleId"])
+	}
+	return rule, nil
+}
+
+func (s *Server) getRules(req *request) (any, error) {
+	wit, err := s.getWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return collection(wit.rules), nil
+}
+
+func (s *Server) getState(req *request) (any, error) {
+	wit, err := s.getWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, state := range wit.states {
+		if strings.EqualFold(state.Id.String(), req.params["stateId"]) {
+			return state, nil
+		}
+	}
+	return nil, notFound("VS402823: The state '%s' does not exist.", req.params["stateId"])
+}
+
+func (s *Server) getStates(req *request) (any, error) {
+	wit, err := s.getWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return collection(wit.states), nil
+}
+
+func (s *Server) getWorkItemType(req *request) (any, error) {
+	wit, err := s.getWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return wit.value, nil
+}
+
+func (s *Server) getWorkItemTypeField(req *request) (any, error) {
+	wit, err := s.getWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	field := wit.findField(req.params["fieldRefName"])
+	if field == nil {
+		return nil, notFound("TF51535: Cannot find field %s.", req.params["fieldRefName"])
+	}
+	return field, nil
+}
+
+// getWorkItemTypeParam returns the work item type of the route, of either a system or an inherited process.
+func (s *Server) getWorkItemTypeParam(req *request) (*processWorkItemType, error) {
+	process := s.findProcess(req.params["processId"])
+	if process == nil {
+		return nil, notFound("VS402362: The process template '%s' does not exist.", req.params["processId"])
+	}
+
+	var workItemTypes []*processWorkItemType
+	if ip, ok := s.inheritedProcesses[*process.Id]; ok {
+		workItemTypes = s.workItemTypes(ip)
+	} else {
+		workItemTypes = s.systemWorkItemTypes(*process.Id)
+	}
+	for _, wit := range workItemTypes {
+		if strings.EqualFold(*wit.value.ReferenceName, req.params["witRefName"]) {
+			return wit, nil
+		}
+	}
+	return nil, notFound("VS402805: The work item type '%s' does not exist.", req.params["witRefName"])
+}
+
+func (s *Server) getWorkItemTypes(req *request) (any, error) {
+	process := s.findProcess(req.params["processId"])
+	if process == nil {
+		return nil, notFound("VS402362: The process template '%s' does not exist.", req.params["processId"])
+	}
+
+	var workItemTypes []*processWorkItemType
+	if ip, ok := s.inheritedProcesses[*process.Id]; ok {
+		workItemTypes = s.workItemTypes(ip)
+	} else {
+		workItemTypes = s.systemWorkItemTypes(*process.Id)
+	}
+	var result []*processes.ProcessWorkItemType
+	for _, wit := range workItemTypes {
+		result = append(result, wit.value)
+	}
+	return collection(result), nil
+}
+
+func (s *Server) getWorkProcess(req *request) (any, error) {
+	process := s.findProcess(req.params["processId"])
+	if process == nil {
+		return nil, notFound("VS402362: The process template '%s' does not exist.", req.params["processId"])
+	}
+	return s.processInfo(process), nil
+}
+
+func (s *Server) getWorkProcesses(_ *request) (any, error) {
+	var result []*processes.ProcessInfo
+	for i := range s.processes {
+		result = append(result, s.processInfo(&s.processes[i]))
+	}
+	return collection(result), nil
+}
+
+// processInfo returns a process as listed by the work process API, the default flag being kept by the core process.
+func (s *Server) processInfo(process *core.Process) *processes.ProcessInfo {
+	if ip, ok := s.inheritedProcesses[*process.Id]; ok {
+		info := *ip.value
+		info.IsDefault = process.IsDefault
+		return &info
+	}
+	return &processes.ProcessInfo{
+		CustomizationType:   utils.String(processes.CustomizationTypeSystem),
+		Description:         process.Description,
+		IsDefault:           process.IsDefault,
+		IsEnabled:           utils.Bool(true),
+		Name:                process.Name,
+		ParentProcessTypeId: &uuid.Nil,
+		ReferenceName:       utils.String("Microsoft.VSTS.Process." + *process.Name),
+		TypeId:              process.Id,
+	}
+}
+
+// removeFieldFromWorkItemTypes removes a deleted field of the organization from the work item types of the inherited
+// processes.
+func (s *Server) removeFieldFromWorkItemTypes(referenceName string) {
+	for _, ip := range s.inheritedProcesses {
+		for _, wit := range ip.workItemTypes {
+			var fields []*processes.ProcessWorkItemTypeField
+			for _, field := range wit.fields {
+				if !strings.EqualFold(*field.ReferenceName, referenceName) {
+					fields = append(fields, field)
+				}
+			}
+			wit.fields = fields
+		}
+	}
+}
+
+func (s *Server) removeLayoutControl(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, group := findLayoutGroup(wit.layout, req.params["groupId"])
+	if group == nil {
+		return nil, notFound("VS402835: The group '%s' does not exist.", req.params["groupId"])
+	}
+	for i, control := range *group.Controls {
+		if strings.EqualFold(*control.Id, req.params["controlId"]) {
+			if *control.Inherited {
+				return nil, badRequest("VS402838: The inherited control '%s' cannot be removed, it can only be hidden.", *control.Id)
+			}
+			*group.Controls = append((*group.Controls)[:i], (*group.Controls)[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, notFound("VS402839: The control '%s' does not exist in the group '%s'.", req.params["controlId"], req.params["groupId"])
+}
+
+func (s *Server) removeLayoutGroup(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	section := findLayoutSection(wit.layout, req.params["pageId"], req.params["sectionId"])
+	if section == nil {
+		return nil, notFound("VS402835: The section '%s' of the page '%s' does not exist.", req.params["sectionId"], req.params["pageId"])
+	}
+	for i, group := range *section.Groups {
+		if strings.EqualFold(*group.Id, req.params["groupId"]) {
+			if *group.Inherited {
+				return nil, badRequest("VS402838: The inherited group '%s' cannot be removed, it can only be hidden.", *group.Label)
+			}
+			if len(*group.Controls) > 0 {
+				return nil, badRequest("VS402840: The group '%s' cannot be removed while it has controls.", *group.Label)
+			}
+			*section.Groups = append((*section.Groups)[:i], (*section.Groups)[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, notFound("VS402835: The group '%s' does not exist.", req.params["groupId"])
+}
+
+func (s *Server) removeLayoutPage(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, page := range *wit.layout.Pages {
+		if strings.EqualFold(*page.Id, req.params["pageId"]) {
+			if *page.Inherited {
+				return nil, badRequest("VS402838: The inherited page '%s' cannot be removed, it can only be hidden.", *page.Label)
+			}
+			*wit.layout.Pages = append((*wit.layout.Pages)[:i], (*wit.layout.Pages)[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, notFound("VS402835: The page '%s' does not exist.", req.params["pageId"])
+}
+
+// removeWorkItemTypeField removes a field added to a work item type, or restores the settings of an inherited field.
+func (s *Server) removeWorkItemTypeField(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, field := range wit.fields {
+		if !strings.EqualFold(*field.ReferenceName, req.params["fieldRefName"]) {
+			continue
+		}
+		if inherited, ok := wit.defaults[strings.ToLower(*field.ReferenceName)]; ok {
+			*field = inherited
+			return nil, nil
+		}
+		wit.fields = append(wit.fields[:i], wit.fields[i+1:]...)
+		return nil, nil
+	}
+	return nil, notFound("TF51535: Cannot find field %s.", req.params["fieldRefName"])
+}
+
+// systemWorkItemTypes returns new copies of the work item types of a system process.
+func (s *Server) systemWorkItemTypes(processId uuid.UUID) []*processWorkItemType {
+	process := s.findProcess(processId.String())
+	if process == nil {
+		return nil
+	}
+
+	var names, states, categories []string
+	switch *process.Name {
+	case "Agile":
+		names = []string{"Bug", "Epic", "Feature", "Task", "User Story"}
+		states = []string{"New", "Active", "Resolved", "Closed", "Removed"}
+	case "Basic":
+		names = []string{"Epic", "Issue", "Task"}
+		states = []string{"To Do", "Doing", "Done"}
+	case "CMMI":
+		names = []string{"Bug", "Epic", "Feature", "Requirement", "Task"}
+		states = []string{"Proposed", "Active", "Resolved", "Closed"}
+	case "Scrum":
+		names = []string{"Bug", "Epic", "Feature", "Product Backlog Item", "Task"}
+		states = []string{"New", "Approved", "Committed", "Done", "Removed"}
+	}
+	for _, state := range states {
+		switch state {
+		case "New", "Proposed", "To Do":
+			categories = append(categories, processes.StateCategoryProposed)
+		case "Resolved":
+			categories = append(categories, processes.StateCategoryResolved)
+		case "Closed", "Done":
+			categories = append(categories, processes.StateCategoryCompleted)
+		case "Removed":
+			categories = append(categories, processes.StateCategoryRemoved)
+		default:
+			categories = append(categories, processes.StateCategoryInProgress)
+		}
+	}
+
+	var workItemTypes []*processWorkItemType
+	for _, name := range names {
+		wit := newWorkItemType(name, processes.CustomizationTypeSystem)
+		wit.value.ReferenceName = utils.String("Microsoft.VSTS.WorkItemTypes." + strings.ReplaceAll(name, " ", ""))
+		wit.value.Url = utils.String(fmt.Sprintf("%s/_apis/work/processes/%s/workitemtypes/%s", s.URL, processId, *wit.value.ReferenceName))
+		wit.states = newStates(wit, states, categories)
+		workItemTypes = append(workItemTypes, wit)
+	}
+	return workItemTypes
+}
+
+func (s *Server) updateLayoutControl(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, group := findLayoutGroup(wit.layout, req.params["groupId"])
+	if group == nil {
+		return nil, notFound("VS402835: The group '%s' does not exist.", req.params["groupId"])
+	}
+
+	var body processes.Control
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	for i, control := range *group.Controls {
+		if strings.EqualFold(*control.Id, req.params["controlId"]) {
+			control := &(*group.Controls)[i]
+			setLayoutItem(&control.Label, &control.Order, &control.Visible, &control.Overridden, *control.Inherited, body.Label, body.Order, body.Visible)
+			return control, nil
+		}
+	}
+	return nil, notFound("VS402839: The control '%s' does not exist in the group '%s'.", req.params["controlId"], req.params["groupId"])
+}
+
+func (s *Server) updateLayoutGroup(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	section := findLayoutSection(wit.layout, req.params["pageId"], req.params["sectionId"])
+	if section == nil {
+		return nil, notFound("VS402835: The section '%s' of the page '%s' does not exist.", req.params["sectionId"], req.params["pageId"])
+	}
+
+	var body processes.Group
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	for i, group := range *section.Groups {
+		if strings.EqualFold(*group.Id, req.params["groupId"]) {
+			group := &(*section.Groups)[i]
+			setLayoutItem(&group.Label, &group.Order, &group.Visible, &group.Overridden, *group.Inherited, body.Label, body.Order, body.Visible)
+			return group, nil
+		}
+	}
+	return nil, notFound("VS402835: The group '%s' does not exist.", req.params["groupId"])
+}
+
+// updateLayoutPage updates the page whose ID is in the body of the request.
+func (s *Server) updateLayoutPage(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.Page
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Id == nil {
+		return nil, badRequest("The ID of the page is required.")
+	}
+
+	for i, page := range *wit.layout.Pages {
+		if strings.EqualFold(*page.Id, *body.Id) {
+			page := &(*wit.layout.Pages)[i]
+			setLayoutItem(&page.Label, &page.Order, &page.Visible, &page.Overridden, *page.Inherited, body.Label, body.Order, body.Visible)
+			return page, nil
+		}
+	}
+	return nil, notFound("VS402835: The page '%s' does not exist.", *body.Id)
+}
+
+func (s *Server) updatePicklist(req *request) (any, error) {
+	picklist, err := s.getPicklistParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.PickList
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Items != nil && len(*body.Items) == 0 {
+		return nil, badRequest("VS402850: The picklist '%s' must have at least one item.", *picklist.Name)
+	}
+
+	if body.IsSuggested != nil {
+		picklist.IsSuggested = body.IsSuggested
+	}
+	if body.Items != nil {
+		picklist.Items = body.Items
+	}
+	if body.Name != nil && *body.Name != "" {
+		picklist.Name = body.Name
+	}
+	return picklist, nil
+}
+
+func (s *Server) updateRule(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rule := wit.findRule(req.params["ruleId"])
+	if rule == nil {
+		return nil, notFound("VS402825: The rule '%s' does not exist.", req.params["ruleId"])
+	}
+
+	var body processes.ProcessRuleRequest
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if err = wit.validateRule(&body, rule); err != nil {
+		return nil, err
+	}
+
+	setRule(rule, &body)
+	return rule, nil
+}
+
+func (s *Server) updateState(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.WorkItemStateInputModel
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	for _, state := range wit.states {
+		if strings.EqualFold(state.Id.String(), req.params["stateId"]) {
+			if *state.CustomizationType != processes.CustomizationTypeCustom {
+				return nil, badRequest("VS402822: The inherited state '%s' cannot be changed, it can only be hidden.", *state.Name)
+			}
+			if body.Color != nil {
+				state.Color = body.Color
+			}
+			if body.Order != nil {
+				state.Order = body.Order
+			}
+			return state, nil
+		}
+	}
+	return nil, notFound("VS402823: The state '%s' does not exist.", req.params["stateId"])
+}
+
+func (s *Server) updateWorkItemType(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.UpdateProcessWorkItemTypeRequest
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	setWorkItemType(wit.value, body.Color, body.Description, body.Icon, body.IsDisabled)
+	return wit.value, nil
+}
+
+func (s *Server) updateWorkItemTypeField(req *request) (any, error) {
+	wit, err := s.getCustomizedWorkItemTypeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	field := wit.findField(req.params["fieldRefName"])
+	if field == nil {
+		return nil, notFound("TF51535: Cannot find field %s.", req.params["fieldRefName"])
+	}
+
+	var body processes.ProcessWorkItemTypeFieldRequest
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	setWorkItemTypeField(field, &body)
+	if _, ok := wit.defaults[strings.ToLower(*field.ReferenceName)]; ok {
+		field.Customization = utils.String(processes.CustomizationTypeInherited)
+	}
+	return field, nil
+}
+
+// updateWorkProcess updates an inherited process, making it the default process of the organization when requested.
+func (s *Server) updateWorkProcess(req *request) (any, error) {
+	ip, err := s.getInheritedProcessParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body processes.UpdateProcessModel
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	process := s.findProcess(ip.value.TypeId.String())
+	isDefault := *process.IsDefault || (body.IsDefault != nil && *body.IsDefault)
+	isEnabled := utils.IfThenElse[bool](body.IsEnabled != nil, body.IsEnabled != nil && *body.IsEnabled, *ip.value.IsEnabled)
+	if isDefault && !isEnabled {
+		return nil, badRequest("VS402813: The default process '%s' cannot be disabled.", *process.Name)
+	}
+	if body.Name != nil && *body.Name != "" && !strings.EqualFold(*body.Name, *process.Name) {
+		if s.findProcessByName(*body.Name) != nil {
+			return nil, conflict("VS402802: The process '%s' already exists.", *body.Name)
+		}
+		ip.value.Name = body.Name
+		process.Name = body.Name
+	}
+
+	if body.Description != nil {
+		ip.value.Description = body.Description
+		process.Description = body.Description
+	}
+	if body.IsDefault != nil && *body.IsDefault {
+		for i := range s.processes {
+			s.processes[i].IsDefault = utils.Bool(false)
+		}
+		process.IsDefault = utils.Bool(true)
+	}
+	ip.value.IsEnabled = utils.Bool(isEnabled)
+	return s.processInfo(process), nil
+}
+
+// workItemTypes returns the work item types of an inherited process, the ones of its parent process being replaced
+// by the overridden ones.
+func (s *Server) workItemTypes(ip *inheritedProcess) []*processWorkItemType {
+	var workItemTypes []*processWorkItemType
+	for _, wit := range s.systemWorkItemTypes(*ip.value.ParentProcessTypeId) {
+		for _, w := range ip.workItemTypes {
+			if w.value.Inherits != nil && strings.EqualFold(*w.value.Inherits, *wit.value.ReferenceName) {
+				wit = w
+				break
+			}
+		}
+		workItemTypes = append(workItemTypes, wit)
+	}
+	for _, wit := range ip.workItemTypes {
+		if *wit.value.Customization == processes.CustomizationTypeCustom {
+			workItemTypes = append(workItemTypes, wit)
+		}
+	}
+	return workItemTypes
+}
+
+func (w *processWorkItemType) findField(referenceName string) *processes.ProcessWorkItemTypeField {
+	for _, field := range w.fields {
+		if strings.EqualFold(*field.ReferenceName, referenceName) {
+			return field
+		}
+	}
+	return nil
+}
+
+func (w *processWorkItemType) findRule(ruleId string) (int, *processes.ProcessRule) {
+	for i, rule := range w.rules {
+		if strings.EqualFold(rule.Id.String(), ruleId) {
+			return i, rule
+		}
+	}
+	return -1, nil
+}
+
+func (w *processWorkItemType) findState(name string) *processes.WorkItemStateResultModel {
+	for _, state := range w.states {
+		if strings.EqualFold(*state.Name, name) {
+			return state
+		}
+	}
+	return nil
+}
+
+// markInherited marks the fields, the layout and the states of a copy of a system work item type as inherited by the
+// process overriding it.
+func (w *processWorkItemType) markInherited() {
+	w.defaults = map[string]processes.ProcessWorkItemTypeField{}
+	for _, field := range w.fields {
+		field.Customization = utils.String(processes.CustomizationTypeInherited)
+		w.defaults[strings.ToLower(*field.ReferenceName)] = *field
+	}
+	for i := range *w.layout.Pages {
+		page := &(*w.layout.Pages)[i]
+		page.Inherited = utils.Bool(true)
+		for _, section := range *page.Sections {
+			for j := range *section.Groups {
+				group := &(*section.Groups)[j]
+				group.Inherited = utils.Bool(true)
+				for k := range *group.Controls {
+					(*group.Controls)[k].Inherited = utils.Bool(true)
+				}
+			}
+		}
+	}
+	for _, state := range w.states {
+		state.CustomizationType = utils.String(processes.CustomizationTypeInherited)
+	}
+}
+
+func (w *processWorkItemType) validateRule(body *processes.ProcessRuleRequest, rule *processes.ProcessRule) error {
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return badRequest("The name of the rule is required.")
+	}
+	if body.Conditions == nil || len(*body.Conditions) == 0 || len(*body.Conditions) > 2 {
+		return badRequest("VS402826: A rule must have one or two conditions.")
+	}
+	if body.Actions == nil || len(*body.Actions) == 0 {
+		return badRequest("VS402827: A rule must have at least one action.")
+	}
+	for _, r := range w.rules {
+		if r != rule && strings.EqualFold(*r.Name, *body.Name) {
+			return conflict("VS402828: The rule '%s' already exists.", *body.Name)
+		}
+	}
+	for _, action := range *body.Actions {
+		if action.TargetField == nil || w.findField(*action.TargetField) == nil {
+			return badRequest("VS402829: The target field of the action '%s' is not a field of the work item type.", *utils.IfThenElse[*string](action.ActionType != nil, action.ActionType, utils.EmptyString))
+		}
+	}
+	return nil
+}
+
+// findLayoutControl returns the control of a field, wherever it is in the layout.
+func findLayoutControl(layout *processes.FormLayout, controlId string) *processes.Control {
+	for _, page := range *layout.Pages {
+		for _, section := range *page.Sections {
+			for _, group := range *section.Groups {
+				for i, control := range *group.Controls {
+					if strings.EqualFold(*control.Id, controlId) {
+						return &(*group.Controls)[i]
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func findLayoutGroup(layout *processes.FormLayout, groupId string) (*processes.Page, *processes.Section, *processes.Group) {
+	for i, page := range *layout.Pages {
+		for j, section := range *page.Sections {
+			for k, group := range *section.Groups {
+				if strings.EqualFold(*group.Id, groupId) {
+					return &(*layout.Pages)[i], &(*page.Sections)[j], &(*section.Groups)[k]
+				}
+			}
+		}
+	}
+	return nil, nil, nil
+}
+
+func findLayoutSection(layout *processes.FormLayout, pageId string, sectionId string) *processes.Section {
+	for _, page := range *layout.Pages {
+		if !strings.EqualFold(*page.Id, pageId) {
+			continue
+		}
+		for i, section := range *page.Sections {
+			if strings.EqualFold(*section.Id, sectionId) {
+				return &(*page.Sections)[i]
+			}
+		}
+	}
+	return nil
+}
+
+func isStateCategory(category *string) bool {
+	if category == nil {
+		return false
+	}
+	switch *category {
+	case processes.StateCategoryProposed, processes.StateCategoryInProgress, processes.StateCategoryResolved, processes.StateCategoryCompleted, processes.StateCategoryRemoved:
+		return true
+	}
+	return false
+}
+
+// newStates returns the states of a work item type, e.g. the ones of its system process. The IDs of the states are
+// derived from their names, so the copies of a system work item type share them.
+func newStates(wit *processWorkItemType, names []string, categories []string) []*processes.WorkItemStateResultModel {
+	var states []*processes.WorkItemStateResultModel
+	for i, name := range names {
+		id := uuid.NewSHA1(uuid.NameSpaceOID, []byte(*wit.value.Name+"/"+name))
+		states = append(states, &processes.WorkItemStateResultModel{
+			Color:             utils.String("b2b2b2"),
+			CustomizationType: utils.String(processes.CustomizationTypeSystem),
+			Hidden:            utils.Bool(false),
+			Id:                &id,
+			Name:              utils.String(name),
+			Order:             utils.Int(i + 1),
+			StateCategory:     utils.String(categories[i]),
+		})
+	}
+	return states
+}
+
+// newWorkItemType returns a work item type with the system fields and a Details page, holding the description and
+// the priority of the work items.
+func newWorkItemType(name string, customization string) *processWorkItemType {
+	var fields []*processes.ProcessWorkItemTypeField
+	for _, f := range systemFields() {
+		fields = append(fields, &processes.ProcessWorkItemTypeField{
+			AllowGroups:   utils.Bool(false),
+			Customization: utils.String(processes.CustomizationTypeSystem),
+			Description:   f.Description,
+			Name:          f.Name,
+			ReadOnly:      utils.Bool(false),
+			ReferenceName: f.ReferenceName,
+			Required:      utils.Bool(*f.ReferenceName == "System.Title"),
+			Type:          f.Type,
+		})
+	}
+
+	inherited := utils.Bool(customization == processes.CustomizationTypeSystem)
+	newGroup := func(id string, label string, controlId string) processes.Group {
+		controls := []processes.Control{
+			{ControlType: utils.String(controlTypeField), Id: utils.String(controlId), Inherited: inherited, Label: utils.String(label), Order: utils.Int(0), ReadOnly: utils.Bool(false), Visible: utils.Bool(true)},
+		}
+		return processes.Group{Controls: &controls, Id: utils.String(id), Inherited: inherited, Label: utils.String(label), Order: utils.Int(0), Visible: utils.Bool(true)}
+	}
+	var sections []processes.Section
+	for i := 1; i <= 4; i++ {
+		var groups []processes.Group
+		switch i {
+		case 1:
+			groups = append(groups, newGroup("Description", "Description", "System.Description"))
+		case 2:
+			groups = append(groups, newGroup("Planning", "Planning", "Microsoft.VSTS.Common.Priority"))
+		}
+		sections = append(sections, processes.Section{Groups: &groups, Id: utils.String(fmt.Sprintf("Section%d", i))})
+	}
+	pages := []processes.Page{
+		{Id: utils.String("Details"), Inherited: inherited, Label: utils.String("Details"), Locked: utils.Bool(false), Order: utils.Int(0), PageType: utils.String(processes.PageTypeCustom), Sections: &sections, Visible: utils.Bool(true)},
+	}
+
+	return &processWorkItemType{
+		fields: fields,
+		layout: &processes.FormLayout{Pages: &pages},
+		value: &processes.ProcessWorkItemType{
+			Class:         utils.String(customization),
+			Color:         utils.String("009ccc"),
+			Customization: utils.String(customization),
+			Description:   utils.String(fmt.Sprintf("Tracks a %s.", strings.ToLower(name))),
+			Icon:          utils.String("icon_clipboard"),
+			IsDisabled:    utils.Bool(false),
+			Name:          utils.String(name),
+		},
+	}
+}
+
+func setLayoutItem(label **string, order **int, visible **bool, overridden **bool, inherited bool, newLabel *string, newOrder *int, newVisible *bool) {
+	if newLabel != nil && *newLabel != "" {
+		*label = newLabel
+	}
+	if newOrder != nil {
+		*order = newOrder
+	}
+	if newVisible != nil {
+		*visible = newVisible
+	}
+	if inherited {
+		*overridden = utils.Bool(true)
+	}
+}
+
+func setRule(rule *processes.ProcessRule, body *processes.ProcessRuleRequest) {
+	rule.Actions = body.Actions
+	rule.Conditions = body.Conditions
+	rule.IsDisabled = utils.IfThenElse[*bool](body.IsDisabled != nil, body.IsDisabled, utils.Bool(false))
+	rule.Name = body.Name
+}
+
+func setWorkItemType(wit *processes.ProcessWorkItemType, color *string, description *string, icon *string, isDisabled *bool) {
+	if color != nil {
+		wit.Color = color
+	}
+	if description != nil {
+		wit.Description = description
+	}
+	if icon != nil {
+		wit.Icon = icon
+	}
+	if isDisabled != nil {
+		wit.IsDisabled = isDisabled
+	}
+}
+
+func setWorkItemTypeField(field *processes.ProcessWorkItemTypeField, body *processes.ProcessWorkItemTypeFieldRequest) {
+	if body.AllowGroups != nil {
+		field.AllowGroups = body.AllowGroups
+	}
+	field.DefaultValue = body.DefaultValue
+	if body.ReadOnly != nil {
+		field.ReadOnly = body.ReadOnly
+	}
+	if body.Required != nil {
+		field.Required = body.Required
+	}
+}
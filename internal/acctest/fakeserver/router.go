@@ -0,0 +1,141 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type handlerFunc func(req *request) (any, error)
+
+type request struct {
+	*http.Request
+	header http.Header
+	params map[string]string
+}
+
+type route struct {
+	handler  handlerFunc
+	method   string
+	segments []string
+}
+
+// router matches the escaped path of a request, segment by segment, against the registered patterns. A '{name}'
+// segment captures a single segment and a trailing '{name...}' segment captures the rest of the path.
+type router struct {
+	routes []route
+}
+
+type serverError struct {
+	Id         string `json:"$id"`
+	ErrorCode  int    `json:"errorCode"`
+	EventId    int    `json:"eventId"`
+	Message    string `json:"message"`
+	StatusCode int    `json:"-"`
+	TypeKey    string `json:"typeKey"`
+	TypeName   string `json:"typeName"`
+}
+
+func (e *serverError) Error() string {
+	return e.Message
+}
+
+func newError(statusCode int, message string) *serverError {
+	return &serverError{
+		Id:         "1",
+		EventId:    3000,
+		Message:    message,
+		StatusCode: statusCode,
+		TypeKey:    "FakeServerException",
+		TypeName:   "Microsoft.VisualStudio.Services.Common.FakeServerException, Microsoft.VisualStudio.Services.Common",
+	}
+}
+
+func (r *router) handle(method string, pattern string, handler handlerFunc) {
+	r.routes = append(r.routes, route{
+		handler:  handler,
+		method:   method,
+		segments: strings.Split(pattern, "/"),
+	})
+}
+
+func (r *router) match(req *http.Request) (handlerFunc, map[string]string, error) {
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/") {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, nil, badRequest("Invalid path segment '%s'", segment)
+		}
+		segments = append(segments, unescaped)
+	}
+
+	methodNotAllowed := false
+	for _, route := range r.routes {
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		if route.method != req.Method {
+			methodNotAllowed = true
+			continue
+		}
+		return route.handler, params, nil
+	}
+
+	if methodNotAllowed {
+		return nil, nil, newError(http.StatusMethodNotAllowed, "The requested resource does not support http method '"+req.Method+"'.")
+	}
+	return nil, nil, notFound("API resource location was not found for route '%s'.", req.URL.Path)
+}
+
+func (r *route) match(segments []string) (map[string]string, bool) {
+	params := map[string]string{}
+	for i, pattern := range r.segments {
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "...}") {
+			rest := ""
+			if i < len(segments) {
+				rest = strings.Join(segments[i:], "/")
+			}
+			params[strings.TrimSuffix(strings.TrimPrefix(pattern, "{"), "...}")] = rest
+			return params, true
+		}
+		if i >= len(segments) {
+			return nil, false
+		}
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "}") {
+			params[strings.TrimSuffix(strings.TrimPrefix(pattern, "{"), "}")] = segments[i]
+		} else if !strings.EqualFold(pattern, segments[i]) {
+			return nil, false
+		}
+	}
+	return params, len(segments) == len(r.segments)
+}
+
+func (r *request) decode(v any) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return badRequest(err.Error())
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		return badRequest("Invalid request body: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *request) query(key string) string {
+	return r.URL.Query().Get(key)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*serverError)
+	if !ok {
+		e = newError(http.StatusInternalServerError, err.Error())
+	}
+
+	body, _ := json.Marshal(e)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(e.StatusCode)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,325 @@
+package fakeserver
+
+import (
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// securityNamespaces returns the namespaces managed by the permissions resources, with the bits of their actions as
+// defined by Azure DevOps.
+func securityNamespaces() []security.SecurityNamespaceDescription {
+	return []security.SecurityNamespaceDescription{
+		securityNamespace(security.NamespaceIdBuild, "Build", "/", []string{
+			"ViewBuilds", "EditBuildQuality", "RetainIndefinitely", "DeleteBuilds", "ManageBuildQualities", "DestroyBuilds",
+			"UpdateBuildInformation", "QueueBuilds", "ManageBuildQueue", "StopBuilds", "ViewBuildDefinition",
+			"EditBuildDefinition", "DeleteBuildDefinition", "OverrideBuildCheckInValidation", "AdministerBuildPermissions",
+		}),
+		securityNamespace(security.NamespaceIdCSS, "CSS", ":", []string{
+			"GENERIC_READ", "GENERIC_WRITE", "CREATE_CHILDREN", "DELETE", "WORK_ITEM_READ", "WORK_ITEM_WRITE",
+			"MANAGE_TEST_PLANS", "MANAGE_TEST_SUITES",
+		}),
+		securityNamespace(security.NamespaceIdEnvironment, "Environment", "/", []string{
+			"View", "Manage", "ManageHistory", "Administer", "Use", "Create",
+		}),
+		securityNamespace(security.NamespaceIdGitRepositories, "Git Repositories", "/", []string{
+			"Administer", "GenericRead", "GenericContribute", "ForcePush", "CreateBranch", "CreateTag", "ManageNote",
+			"PolicyExempt", "CreateRepository", "DeleteRepository", "RenameRepository", "EditPolicies", "RemoveOthersLocks",
+			"ManagePermissions", "PullRequestContribute", "PullRequestBypassPolicy",
+		}),
+		securityNamespace(security.NamespaceIdIdentity, "Identity", "\\", []string{
+			"Read", "Write", "Delete", "ManageMembership", "CreateScope", "RestoreScope",
+		}),
+		securityNamespace(security.NamespaceIdIteration, "Iteration", ":", []string{
+			"GENERIC_READ", "GENERIC_WRITE", "CREATE_CHILDREN", "DELETE",
+		}),
+		securityNamespace(security.NamespaceIdProject, "Project", "", []string{
+			"GENERIC_READ", "GENERIC_WRITE", "DELETE", "PUBLISH_TEST_RESULTS", "", "", "", "", "DELETE_TEST_RESULTS",
+			"VIEW_TEST_RESULTS", "", "MANAGE_TEST_ENVIRONMENTS", "MANAGE_TEST_CONFIGURATIONS", "WORK_ITEM_DELETE",
+			"WORK_ITEM_MOVE", "WORK_ITEM_PERMANENTLY_DELETE", "RENAME", "MANAGE_PROPERTIES", "", "", "BYPASS_RULES",
+			"SUPPRESS_NOTIFICATIONS", "UPDATE_VISIBILITY", "CHANGE_PROCESS",
+		}),
+		securityNamespace(security.NamespaceIdServiceEndpoints, "ServiceEndpoints", "/", []string{
+			"Use", "Administer", "Create", "ViewAuthorization", "ViewEndpoint",
+		}),
+	}
+}
+
+// securityNamespace returns the description of a namespace whose actions are given in bit order, an empty name
+// skipping a bit.
+func securityNamespace(namespaceId string, name string, separator string, actions []string) security.SecurityNamespaceDescription {
+	id := uuid.MustParse(namespaceId)
+	var definitions []security.ActionDefinition
+	for i, action := range actions {
+		if action == "" {
+			continue
+		}
+		definitions = append(definitions, security.ActionDefinition{
+			Bit:         utils.Int(1 << i),
+			DisplayName: utils.String(action),
+			Name:        utils.String(action),
+			NamespaceId: &id,
+		})
+	}
+
+	return security.SecurityNamespaceDescription{
+		Actions:        &definitions,
+		DisplayName:    &name,
+		Name:           &name,
+		NamespaceId:    &id,
+		SeparatorValue: &separator,
+	}
+}
+
+func (s *Server) registerSecurityRoutes() {
+	s.router.handle(http.MethodPost, "_apis/accesscontrolentries/{namespaceId}", s.setAccessControlEntries)
+	s.router.handle(http.MethodDelete, "_apis/accesscontrolentries/{namespaceId}", s.removeAccessControlEntries)
+	s.router.handle(http.MethodGet, "_apis/accesscontrollists/{namespaceId}", s.getAccessControlLists)
+	s.router.handle(http.MethodPost, "_apis/accesscontrollists/{namespaceId}", s.setAccessControlLists)
+	s.router.handle(http.MethodDelete, "_apis/accesscontrollists/{namespaceId}", s.removeAccessControlLists)
+	s.router.handle(http.MethodGet, "_apis/securitynamespaces", s.getSecurityNamespaces)
+}
+
+// Private Methods
+
+// extendedInfo computes the permissions an entry inherits from the entries of the same descriptor on the ancestors
+// of the token, and the resulting effective permissions. Explicit permissions always win over inherited ones.
+func (s *Server) extendedInfo(namespaceId string, token string, ace *security.AccessControlEntry) *security.AceExtendedInformation {
+	inheritedAllow, inheritedDeny := 0, 0
+	for _, ancestor := range s.tokenAncestors(namespaceId, token) {
+		acl, ok := s.acls[namespaceId][ancestor]
+		if !ok || (acl.InheritPermissions != nil && !*acl.InheritPermissions) {
+			continue
+		}
+		if parent, ok := (*acl.AcesDictionary)[*ace.Descriptor]; ok {
+			inheritedAllow = (inheritedAllow &^ *parent.Deny) | *parent.Allow
+			inheritedDeny = (inheritedDeny &^ *parent.Allow) | *parent.Deny
+		}
+	}
+
+	return &security.AceExtendedInformation{
+		EffectiveAllow: utils.Int(*ace.Allow | (inheritedAllow &^ *ace.Deny)),
+		EffectiveDeny:  utils.Int(*ace.Deny | (inheritedDeny &^ *ace.Allow)),
+		InheritedAllow: utils.Int(inheritedAllow),
+		InheritedDeny:  utils.Int(inheritedDeny),
+	}
+}
+
+func (s *Server) findNamespace(namespaceId string) *security.SecurityNamespaceDescription {
+	for i, namespace := range s.namespaces {
+		if strings.EqualFold(namespace.NamespaceId.String(), namespaceId) {
+			return &s.namespaces[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) getAccessControlLists(req *request) (any, error) {
+	namespaceId, err := s.getNamespaceParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token := req.query("token")
+	includeExtendedInfo := strings.EqualFold(req.query("includeExtendedInfo"), "true")
+
+	var tokens []string
+	if token != "" {
+		tokens = append(tokens, token)
+	} else {
+		for t := range s.acls[namespaceId] {
+			tokens = append(tokens, t)
+		}
+		sort.Strings(tokens)
+	}
+
+	var acls []security.AccessControlList
+	for _, t := range tokens {
+		acl, ok := s.acls[namespaceId][t]
+		if !ok {
+			continue
+		}
+
+		aces := map[string]security.AccessControlEntry{}
+		for descriptor, ace := range *acl.AcesDictionary {
+			ace := ace
+			if includeExtendedInfo {
+				ace.ExtendedInfo = s.extendedInfo(namespaceId, t, &ace)
+			}
+			aces[descriptor] = ace
+		}
+		acls = append(acls, security.AccessControlList{
+			AcesDictionary:      &aces,
+			IncludeExtendedInfo: &includeExtendedInfo,
+			InheritPermissions:  acl.InheritPermissions,
+			Token:               acl.Token,
+		})
+	}
+	return collection(acls), nil
+}
+
+func (s *Server) getNamespaceParam(req *request) (string, error) {
+	namespace := s.findNamespace(req.params["namespaceId"])
+	if namespace == nil {
+		return "", notFound("The security namespace '%s' does not exist.", req.params["namespaceId"])
+	}
+
+	namespaceId := namespace.NamespaceId.String()
+	if _, ok := s.acls[namespaceId]; !ok {
+		s.acls[namespaceId] = map[string]*security.AccessControlList{}
+	}
+	return namespaceId, nil
+}
+
+func (s *Server) getOrCreateAccessControlList(namespaceId string, token string) *security.AccessControlList {
+	acl, ok := s.acls[namespaceId][token]
+	if !ok {
+		acl = &security.AccessControlList{
+			AcesDictionary:     &map[string]security.AccessControlEntry{},
+			InheritPermissions: utils.Bool(true),
+			Token:              utils.String(token),
+		}
+		s.acls[namespaceId][token] = acl
+	}
+	return acl
+}
+
+func (s *Server) getSecurityNamespaces(_ *request) (any, error) {
+	return collection(s.namespaces), nil
+}
+
+func (s *Server) removeAccessControlEntries(req *request) (any, error) {
+	namespaceId, err := s.getNamespaceParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	acl, ok := s.acls[namespaceId][req.query("token")]
+	if !ok {
+		return false, nil
+	}
+
+	removed := false
+	for _, descriptor := range splitList(req.query("descriptors")) {
+		for key := range *acl.AcesDictionary {
+			if strings.EqualFold(key, descriptor) {
+				delete(*acl.AcesDictionary, key)
+				removed = true
+			}
+		}
+	}
+	return removed, nil
+}
+
+func (s *Server) removeAccessControlLists(req *request) (any, error) {
+	namespaceId, err := s.getNamespaceParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := false
+	for _, token := range splitList(req.query("tokens")) {
+		if _, ok := s.acls[namespaceId][token]; ok {
+			delete(s.acls[namespaceId], token)
+			removed = true
+		}
+	}
+	return removed, nil
+}
+
+func (s *Server) setAccessControlEntries(req *request) (any, error) {
+	namespaceId, err := s.getNamespaceParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body security.SetAccessControlEntriesArgs
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Token == nil || *body.Token == "" {
+		return nil, badRequest("The token of the access control entries is required.")
+	}
+	if body.AccessControlEntries == nil {
+		return nil, badRequest("The access control entries are required.")
+	}
+
+	merge := body.Merge != nil && *body.Merge
+	acl := s.getOrCreateAccessControlList(namespaceId, *body.Token)
+	var aces []security.AccessControlEntry
+	for _, ace := range *body.AccessControlEntries {
+		if ace.Descriptor == nil || *ace.Descriptor == "" {
+			return nil, badRequest("The descriptor of the access control entry is required.")
+		}
+
+		allow := utils.IfThenElse[*int](ace.Allow != nil, ace.Allow, utils.Int(0))
+		deny := utils.IfThenElse[*int](ace.Deny != nil, ace.Deny, utils.Int(0))
+		if existing, ok := (*acl.AcesDictionary)[*ace.Descriptor]; ok && merge {
+			*allow = (*existing.Allow &^ *deny) | *allow
+			*deny = (*existing.Deny &^ *allow) | *deny
+		}
+
+		entry := security.AccessControlEntry{Allow: allow, Deny: deny, Descriptor: ace.Descriptor}
+		(*acl.AcesDictionary)[*ace.Descriptor] = entry
+		aces = append(aces, entry)
+	}
+	return collection(aces), nil
+}
+
+func (s *Server) setAccessControlLists(req *request) (any, error) {
+	namespaceId, err := s.getNamespaceParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body security.AccessControlListCollection
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Value == nil {
+		return nil, badRequest("The access control lists are required.")
+	}
+
+	for _, acl := range *body.Value {
+		if acl.Token == nil || *acl.Token == "" {
+			return nil, badRequest("The token of the access control list is required.")
+		}
+
+		aces := map[string]security.AccessControlEntry{}
+		if acl.AcesDictionary != nil {
+			for descriptor, ace := range *acl.AcesDictionary {
+				aces[descriptor] = security.AccessControlEntry{
+					Allow:      utils.IfThenElse[*int](ace.Allow != nil, ace.Allow, utils.Int(0)),
+					Deny:       utils.IfThenElse[*int](ace.Deny != nil, ace.Deny, utils.Int(0)),
+					Descriptor: utils.String(descriptor),
+				}
+			}
+		}
+		s.acls[namespaceId][*acl.Token] = &security.AccessControlList{
+			AcesDictionary:     &aces,
+			InheritPermissions: utils.IfThenElse[*bool](acl.InheritPermissions != nil, acl.InheritPermissions, utils.Bool(true)),
+			Token:              acl.Token,
+		}
+	}
+	return nil, nil
+}
+
+// tokenAncestors returns the ancestors of a token, from the root to the direct parent. Tokens of namespaces without
+// separator have no ancestors.
+func (s *Server) tokenAncestors(namespaceId string, token string) []string {
+	namespace := s.findNamespace(namespaceId)
+	if namespace == nil || namespace.SeparatorValue == nil || *namespace.SeparatorValue == "" {
+		return nil
+	}
+
+	separator := *namespace.SeparatorValue
+	parts := strings.Split(token, separator)
+	var ancestors []string
+	for i := 1; i < len(parts); i++ {
+		ancestors = append(ancestors, strings.Join(parts[:i], separator))
+	}
+	return ancestors
+}
@@ -0,0 +1,275 @@
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultApiVersion   = "7.1"
+	defaultPageSize     = 100
+	defaultPendingPolls = 1
+)
+
+// Server is an in-process fake of the Azure DevOps REST APIs called by the clients of the provider. It keeps its
+// state in memory, so a single server can back a whole acceptance test, from the creation of the resources to their
+// destruction.
+type Server struct {
+	*httptest.Server
+
+	config *ServerConfig
+	mu     sync.Mutex
+	router *router
+	seq    int
+
+	acls                map[string]map[string]*security.AccessControlList
+	agentPools          map[int]*pipelines.TaskAgentPool
+	agentQueues         map[int]*agentQueue
+	classificationNodes map[string]*classificationNode
+	environmentK8s      map[int]*environmentKubernetes
+	environments        map[int]*environment
+	fields              map[string]*workitems.WorkItemField
+	inheritedProcesses  map[uuid.UUID]*inheritedProcess
+	namespaces          []security.SecurityNamespaceDescription
+	operations          map[uuid.UUID]*operation
+	picklists           map[uuid.UUID]*processes.PickList
+	pipelinePermissions map[string]*pipelines.ResourcePipelinePermissions
+	processes           []core.Process
+	projects            map[uuid.UUID]*project
+	serviceEndpoints    map[uuid.UUID]*serviceEndpoint
+	subjects            map[string]*subject
+}
+
+type ServerConfig struct {
+	ApiVersion   string // Highest version of the REST APIs served, e.g. '6.0' to behave like Azure DevOps Server 2020
+	PageSize     int    // Number of items of a page of the paged APIs, when $top is not set
+	PendingPolls int    // Number of polls an async operation or a service endpoint stays pending for
+}
+
+// NewServer starts a fake Azure DevOps organization, to be closed by the caller. The url of the server is used as
+// organization url, the Graph and Identities APIs are served by the same server.
+func NewServer(config *ServerConfig) *Server {
+	if config == nil {
+		config = &ServerConfig{}
+	}
+	if config.ApiVersion == "" {
+		config.ApiVersion = defaultApiVersion
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = defaultPageSize
+	}
+	if config.PendingPolls < 0 {
+		config.PendingPolls = 0
+	} else if config.PendingPolls == 0 {
+		config.PendingPolls = defaultPendingPolls
+	}
+
+	s := &Server{
+		acls:                map[string]map[string]*security.AccessControlList{},
+		agentPools:          map[int]*pipelines.TaskAgentPool{},
+		agentQueues:         map[int]*agentQueue{},
+		classificationNodes: map[string]*classificationNode{},
+		config:              config,
+		environmentK8s:      map[int]*environmentKubernetes{},
+		environments:        map[int]*environment{},
+		fields:              systemFields(),
+		inheritedProcesses:  map[uuid.UUID]*inheritedProcess{},
+		namespaces:          securityNamespaces(),
+		operations:          map[uuid.UUID]*operation{},
+		picklists:           map[uuid.UUID]*processes.PickList{},
+		pipelinePermissions: map[string]*pipelines.ResourcePipelinePermissions{},
+		processes:           systemProcesses(),
+		projects:            map[uuid.UUID]*project{},
+		router:              &router{},
+		serviceEndpoints:    map[uuid.UUID]*serviceEndpoint{},
+		subjects:            map[string]*subject{},
+	}
+	// The picklists share their path with the migration of the process of a project, so their routes come first
+	s.registerProcessesRoutes()
+	s.registerCoreRoutes()
+	s.registerGraphRoutes()
+	s.registerLocationRoutes()
+	s.registerPipelinesRoutes()
+	s.registerSecurityRoutes()
+	s.registerServiceEndpointsRoutes()
+	s.registerWorkRoutes()
+	s.registerWorkItemsRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Private Methods
+
+func (s *Server) nextId() int {
+	s.seq++
+	return s.seq
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") == "" {
+		writeError(w, newError(http.StatusUnauthorized, "TF400813: The user is not authorized to access this resource."))
+		return
+	}
+	// The resource locations are read without any version, to learn the supported ones
+	if apiVersion := r.URL.Query().Get("api-version"); r.Method != http.MethodOptions {
+		if apiVersion == "" {
+			writeError(w, newError(http.StatusBadRequest, "VS800025: The api-version query parameter is required."))
+			return
+		}
+		if !isSupportedApiVersion(apiVersion, s.config.ApiVersion) {
+			writeError(w, newError(http.StatusBadRequest, fmt.Sprintf("VS800071: The requested REST API version of %s is out of range for this server. The latest REST API version this server supports is %s.", apiVersion, s.config.ApiVersion)))
+			return
+		}
+	}
+
+	handler, params, err := s.router.match(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	s.mu.Lock()
+	req := &request{Request: r, header: w.Header(), params: params}
+	result, err := handler(req)
+	s.mu.Unlock()
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if result == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		writeError(w, newError(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// paginate returns a page of items starting at the continuation token of the request, and sets the continuation
+// token of the next page on the response.
+func paginate[T any](s *Server, req *request, items []T, pageSize int) []T {
+	if pageSize <= 0 {
+		pageSize = s.config.PageSize
+	}
+
+	start, _ := strconv.Atoi(req.URL.Query().Get("continuationToken"))
+	if start < 0 || start > len(items) {
+		start = len(items)
+	}
+
+	end := start + pageSize
+	if end < len(items) {
+		req.header.Set("X-MS-ContinuationToken", strconv.Itoa(end))
+	} else {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+// skipTop returns the page of items selected by the $skip and $top parameters of the request, the page size of the
+// server being used without $top.
+func skipTop[T any](s *Server, req *request, items []T) []T {
+	skip, _ := strconv.Atoi(req.query("$skip"))
+	top, _ := strconv.Atoi(req.query("$top"))
+	if top <= 0 {
+		top = s.config.PageSize
+	}
+
+	if skip < 0 || skip > len(items) {
+		skip = len(items)
+	}
+	end := skip + top
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[skip:end]
+}
+
+func collection[T any](items []T) map[string]any {
+	if items == nil {
+		items = []T{}
+	}
+	return map[string]any{"count": len(items), "value": items}
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// isSupportedApiVersion returns whether a version like '7.1-preview.1' is at most the version of the server.
+func isSupportedApiVersion(apiVersion string, serverApiVersion string) bool {
+	parse := func(version string) (int, int) {
+		var major, minor int
+		_, _ = fmt.Sscanf(strings.SplitN(version, "-", 2)[0], "%d.%d", &major, &minor)
+		return major, minor
+	}
+
+	major, minor := parse(apiVersion)
+	serverMajor, serverMinor := parse(serverApiVersion)
+	return major < serverMajor || (major == serverMajor && minor <= serverMinor)
+}
+
+func notFound(format string, args ...any) error {
+	return newError(http.StatusNotFound, fmt.Sprintf(format, args...))
+}
+
+func badRequest(format string, args ...any) error {
+	return newError(http.StatusBadRequest, fmt.Sprintf(format, args...))
+}
+
+func conflict(format string, args ...any) error {
+	return newError(http.StatusConflict, fmt.Sprintf(format, args...))
+}
+
+// emptyToNil returns nil for an empty string, which Azure DevOps omits from its responses.
+func emptyToNil(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
+
+func removeItem(items []string, item string) []string {
+	var result []string
+	for _, i := range items {
+		if i != item {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func containsFold(items []string, item string) bool {
+	for _, i := range items {
+		if strings.EqualFold(i, item) {
+			return true
+		}
+	}
+	return false
+}
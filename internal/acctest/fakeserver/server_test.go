@@ -0,0 +1,157 @@
+package fakeserver_test
+
+import (
+	"context"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"testing"
+)
+
+const agileProcessId = "adcc42ab-9882-485e-a3ed-7678f01f66bc"
+
+func TestServer_AsyncOperation(t *testing.T) {
+	ctx := context.Background()
+	client := acctest.NewClient(acctest.NewServer(t, &fakeserver.ServerConfig{PendingPolls: 2}))
+
+	operation, err := client.CoreClient.CreateProject(ctx, "Sandbox", "", "private", agileProcessId, "Git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *operation.Status != "queued" {
+		t.Fatalf("expected a queued operation, got '%s'", *operation.Status)
+	}
+
+	for i, expected := range []string{"inProgress", "inProgress", "succeeded", "succeeded"} {
+		project, err := client.CoreClient.GetProject(ctx, "Sandbox")
+		if err != nil {
+			t.Fatalf("poll %d: %v", i, err)
+		}
+		if state := string(*project.State); (state == "wellFormed") != (i == 3) {
+			t.Fatalf("poll %d: unexpected project state '%s'", i, state)
+		}
+
+		op, err := client.CoreClient.GetOperation(ctx, operation.Id.String(), nil)
+		if err != nil {
+			t.Fatalf("poll %d: %v", i, err)
+		}
+		if *op.Status != expected {
+			t.Fatalf("poll %d: expected status '%s', got '%s'", i, expected, *op.Status)
+		}
+	}
+}
+
+func TestServer_ApiVersionNegotiation(t *testing.T) {
+	// The clients request version 7.0, which Azure DevOps Server 2020 rejects
+	client := acctest.NewClient(acctest.NewServer(t, &fakeserver.ServerConfig{ApiVersion: "6.0"}))
+
+	projectId := createProject(t, client)
+	groups, err := client.GraphClient.GetGroups(context.Background(), projectId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*groups) == 0 {
+		t.Fatal("expected the groups of the project")
+	}
+}
+
+func TestServer_ContinuationToken(t *testing.T) {
+	ctx := context.Background()
+	paged := acctest.NewClient(acctest.NewServer(t, &fakeserver.ServerConfig{PageSize: 1}))
+	unpaged := acctest.NewClient(acctest.NewServer(t, nil))
+
+	var counts []int
+	for _, client := range []*clients.AzureDevOpsClient{paged, unpaged} {
+		projectId := createProject(t, client)
+		groups, err := client.GraphClient.GetGroups(ctx, projectId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts = append(counts, len(*groups))
+	}
+
+	if counts[0] < 2 || counts[0] != counts[1] {
+		t.Fatalf("expected every group to be listed across the pages, got %d groups paged and %d unpaged", counts[0], counts[1])
+	}
+}
+
+func TestServer_AccessControlEntries(t *testing.T) {
+	ctx := context.Background()
+	client := acctest.NewClient(acctest.NewServer(t, nil))
+	projectId := createProject(t, client)
+	token := client.SecurityClient.GetProjectToken(projectId)
+	descriptor := "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1204400969-2402986413-2179408616-3-1"
+
+	aces := []security.AccessControlEntry{{Allow: utils.Int(3), Deny: utils.Int(4), Descriptor: &descriptor}}
+	if err := client.SecurityClient.SetAccessControlEntries(ctx, security.NamespaceIdProject, token, &aces); err != nil {
+		t.Fatal(err)
+	}
+	checkAccessControlEntry(t, client, token, descriptor, 3, 4)
+
+	// The entries are replaced, not merged
+	aces = []security.AccessControlEntry{{Allow: utils.Int(1), Descriptor: &descriptor}}
+	if err := client.SecurityClient.SetAccessControlEntries(ctx, security.NamespaceIdProject, token, &aces); err != nil {
+		t.Fatal(err)
+	}
+	checkAccessControlEntry(t, client, token, descriptor, 1, 0)
+
+	if err := client.SecurityClient.RemoveAccessControlEntries(ctx, security.NamespaceIdProject, token, []string{descriptor}); err != nil {
+		t.Fatal(err)
+	}
+	acls, err := client.SecurityClient.GetAccessControlLists(ctx, security.NamespaceIdProject, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, acl := range *acls.Value {
+		if _, ok := (*acl.AcesDictionary)[descriptor]; ok {
+			t.Fatal("the access control entry was not removed")
+		}
+	}
+}
+
+func checkAccessControlEntry(t *testing.T, client *clients.AzureDevOpsClient, token string, descriptor string, allow int, deny int) {
+	t.Helper()
+
+	acls, err := client.SecurityClient.GetAccessControlLists(context.Background(), security.NamespaceIdProject, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*acls.Value) != 1 {
+		t.Fatalf("expected one access control list, got %d", len(*acls.Value))
+	}
+
+	ace, ok := (*(*acls.Value)[0].AcesDictionary)[descriptor]
+	if !ok {
+		t.Fatal("the access control entry does not exist")
+	}
+	if *ace.Allow != allow || *ace.Deny != deny {
+		t.Fatalf("expected allow %d and deny %d, got allow %d and deny %d", allow, deny, *ace.Allow, *ace.Deny)
+	}
+	if *ace.ExtendedInfo.EffectiveAllow != allow || *ace.ExtendedInfo.EffectiveDeny != deny {
+		t.Fatalf("expected effective allow %d and deny %d, got allow %d and deny %d", allow, deny, *ace.ExtendedInfo.EffectiveAllow, *ace.ExtendedInfo.EffectiveDeny)
+	}
+}
+
+func createProject(t *testing.T, client *clients.AzureDevOpsClient) string {
+	t.Helper()
+
+	operation, err := client.CoreClient.CreateProject(context.Background(), "Sandbox", "", "private", agileProcessId, "Git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for status := *operation.Status; status != "succeeded"; {
+		op, err := client.CoreClient.GetOperation(context.Background(), operation.Id.String(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		status = *op.Status
+	}
+
+	project, err := client.CoreClient.GetProject(context.Background(), "Sandbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return project.Id.String()
+}
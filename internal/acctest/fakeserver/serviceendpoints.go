@@ -0,0 +1,255 @@
+package fakeserver
+
+import (
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"strings"
+)
+
+const (
+	serviceEndpointStateInProgress = "InProgress"
+	serviceEndpointStateReady      = "Ready"
+)
+
+// secretParameters are the authorization parameters never returned by the REST API.
+var secretParameters = []string{
+	serviceendpoints.ServiceEndpointAuthorizationParamsAccessToken,
+	serviceendpoints.ServiceEndpointAuthorizationParamsApiToken,
+	serviceendpoints.ServiceEndpointAuthorizationParamsKubeconfig,
+	serviceendpoints.ServiceEndpointAuthorizationParamsNuGetKey,
+	serviceendpoints.ServiceEndpointAuthorizationParamsPassword,
+	serviceendpoints.ServiceEndpointAuthorizationParamsServicePrincipalKey,
+}
+
+type serviceEndpoint struct {
+	polls int
+	value *serviceendpoints.ServiceEndpoint
+}
+
+func (s *Server) registerServiceEndpointsRoutes() {
+	s.router.handle(http.MethodPost, "{projectId}/_apis/serviceendpoint/endpoints", s.createServiceEndpoint)
+	s.router.handle(http.MethodGet, "{projectId}/_apis/serviceendpoint/endpoints/{endpointId}", s.getServiceEndpoint)
+	s.router.handle(http.MethodPatch, "{projectId}/_apis/serviceendpoint/endpoints/{endpointId}", s.shareServiceEndpoint)
+	s.router.handle(http.MethodPut, "_apis/serviceendpoint/endpoints/{endpointId}", s.updateServiceEndpoint)
+	s.router.handle(http.MethodDelete, "_apis/serviceendpoint/endpoints/{endpointId}", s.deleteServiceEndpoint)
+}
+
+// Private Methods
+
+func (s *Server) createServiceEndpoint(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body serviceendpoints.ServiceEndpoint
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" || body.Type == nil {
+		return nil, badRequest("The name and the type of the service endpoint are required.")
+	}
+	if s.findServiceEndpoint(*p.value.Id, *body.Name) != nil {
+		return nil, conflict("Service connection with name %s already exists.", *body.Name)
+	}
+
+	id := uuid.New()
+	body.Description = utils.IfThenElse[*string](body.Description != nil, body.Description, utils.EmptyString)
+	body.Id = &id
+	body.IsReady = utils.Bool(s.config.PendingPolls == 0)
+	body.IsShared = utils.Bool(false)
+	body.ServiceEndpointProjectReferences = &[]serviceendpoints.ServiceEndpointProjectReference{
+		{Description: body.Description, Name: body.Name, ProjectReference: &core.ProjectReference{Id: p.value.Id, Name: p.value.Name}},
+	}
+	s.serviceEndpoints[id] = &serviceEndpoint{value: &body}
+	return s.serviceEndpointResult(s.serviceEndpoints[id]), nil
+}
+
+func (s *Server) deleteServiceEndpoint(req *request) (any, error) {
+	se, err := s.getServiceEndpointParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIds := splitList(req.query("projectIds"))
+	if len(projectIds) == 0 {
+		return nil, badRequest("The projectIds query parameter is required.")
+	}
+
+	var references []serviceendpoints.ServiceEndpointProjectReference
+	for _, reference := range *se.value.ServiceEndpointProjectReferences {
+		removed := false
+		for _, projectId := range projectIds {
+			if strings.EqualFold(reference.ProjectReference.Id.String(), projectId) {
+				removed = true
+			}
+		}
+		if !removed {
+			references = append(references, reference)
+		}
+	}
+
+	if len(references) == 0 {
+		delete(s.serviceEndpoints, *se.value.Id)
+	} else {
+		se.value.IsShared = utils.Bool(len(references) > 1)
+		se.value.ServiceEndpointProjectReferences = &references
+	}
+	return nil, nil
+}
+
+func (s *Server) findServiceEndpoint(projectId uuid.UUID, name string) *serviceEndpoint {
+	for _, se := range s.serviceEndpoints {
+		for _, reference := range *se.value.ServiceEndpointProjectReferences {
+			if *reference.ProjectReference.Id == projectId && strings.EqualFold(*reference.Name, name) {
+				return se
+			}
+		}
+	}
+	return nil
+}
+
+// getServiceEndpoint returns a service endpoint which stays pending for the configured number of polls, like the
+// endpoints whose creation is asynchronous.
+func (s *Server) getServiceEndpoint(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	se, err := s.getServiceEndpointParam(req)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isServiceEndpointShared(se, *p.value.Id) {
+		return nil, s.serviceEndpointNotFound(req)
+	}
+
+	if !*se.value.IsReady {
+		se.polls++
+		se.value.IsReady = utils.Bool(se.polls >= s.config.PendingPolls)
+	}
+	return s.serviceEndpointResult(se), nil
+}
+
+func (s *Server) getServiceEndpointParam(req *request) (*serviceEndpoint, error) {
+	id, err := uuid.Parse(req.params["endpointId"])
+	if err != nil {
+		return nil, s.serviceEndpointNotFound(req)
+	}
+
+	se, ok := s.serviceEndpoints[id]
+	if !ok {
+		return nil, s.serviceEndpointNotFound(req)
+	}
+	return se, nil
+}
+
+func (s *Server) isServiceEndpointShared(se *serviceEndpoint, projectId uuid.UUID) bool {
+	for _, reference := range *se.value.ServiceEndpointProjectReferences {
+		if *reference.ProjectReference.Id == projectId {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) serviceEndpointNotFound(req *request) error {
+	return notFound("Service connection with id %s does not exist.", req.params["endpointId"])
+}
+
+// serviceEndpointResult returns a copy of the service endpoint without the secrets of its authorization.
+func (s *Server) serviceEndpointResult(se *serviceEndpoint) *serviceendpoints.ServiceEndpoint {
+	result := *se.value
+	if se.value.Authorization != nil && se.value.Authorization.Parameters != nil {
+		parameters := map[string]string{}
+		for key, value := range *se.value.Authorization.Parameters {
+			if !containsFold(secretParameters, key) {
+				parameters[key] = value
+			}
+		}
+		result.Authorization = &serviceendpoints.EndpointAuthorization{Parameters: &parameters, Scheme: se.value.Authorization.Scheme}
+	}
+
+	state := utils.IfThenElse[string](*se.value.IsReady, serviceEndpointStateReady, serviceEndpointStateInProgress)
+	result.OperationStatus = map[string]string{"state": state}
+	return &result
+}
+
+func (s *Server) shareServiceEndpoint(req *request) (any, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	se, err := s.getServiceEndpointParam(req)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isServiceEndpointShared(se, *p.value.Id) {
+		return nil, s.serviceEndpointNotFound(req)
+	}
+
+	var body []serviceendpoints.ServiceEndpointProjectReference
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	references := *se.value.ServiceEndpointProjectReferences
+	for _, reference := range body {
+		if reference.ProjectReference == nil || reference.ProjectReference.Id == nil {
+			return nil, badRequest("The project of the service endpoint reference is required.")
+		}
+		target := s.findProject(reference.ProjectReference.Id.String())
+		if target == nil {
+			return nil, notFound("VS800075: The project with id '%s' does not exist, or you do not have permission to access it.", reference.ProjectReference.Id)
+		}
+		if s.isServiceEndpointShared(se, *target.value.Id) {
+			continue
+		}
+		references = append(references, serviceendpoints.ServiceEndpointProjectReference{
+			Description:      utils.IfThenElse[*string](reference.Description != nil, reference.Description, se.value.Description),
+			Name:             utils.IfThenElse[*string](reference.Name != nil, reference.Name, se.value.Name),
+			ProjectReference: &core.ProjectReference{Id: target.value.Id, Name: target.value.Name},
+		})
+		se.value.ServiceEndpointProjectReferences = &references
+	}
+
+	se.value.IsShared = utils.Bool(len(references) > 1)
+	return nil, nil
+}
+
+func (s *Server) updateServiceEndpoint(req *request) (any, error) {
+	se, err := s.getServiceEndpointParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body serviceendpoints.ServiceEndpoint
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The name of the service endpoint is required.")
+	}
+
+	se.value.Authorization = body.Authorization
+	se.value.Data = body.Data
+	se.value.Description = utils.IfThenElse[*string](body.Description != nil, body.Description, utils.EmptyString)
+	se.value.Name = body.Name
+	se.value.Url = body.Url
+	if body.ServiceEndpointProjectReferences != nil {
+		for i, reference := range *se.value.ServiceEndpointProjectReferences {
+			for _, updated := range *body.ServiceEndpointProjectReferences {
+				if updated.ProjectReference != nil && updated.ProjectReference.Id != nil && *updated.ProjectReference.Id == *reference.ProjectReference.Id {
+					(*se.value.ServiceEndpointProjectReferences)[i].Description = updated.Description
+					(*se.value.ServiceEndpointProjectReferences)[i].Name = updated.Name
+				}
+			}
+		}
+	}
+	return s.serviceEndpointResult(se), nil
+}
@@ -0,0 +1,345 @@
+package fakeserver
+
+import (
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/work"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"strings"
+)
+
+var weekDays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// teamSettings are the agile settings of a team, created with the defaults of Azure DevOps on first use.
+type teamSettings struct {
+	areas                 []teamArea
+	backlogIteration      *classificationNode
+	backlogVisibilities   map[string]bool
+	bugsBehavior          string
+	defaultIteration      *classificationNode
+	defaultArea           *classificationNode
+	defaultIterationMacro string
+	iterations            []*classificationNode
+	workingDays           []string
+}
+
+type teamArea struct {
+	includeChildren bool
+	node            *classificationNode
+}
+
+func (s *Server) registerWorkRoutes() {
+	s.router.handle(http.MethodGet, "{projectId}/{teamId}/_apis/work/teamsettings", s.getTeamSettings)
+	s.router.handle(http.MethodPatch, "{projectId}/{teamId}/_apis/work/teamsettings", s.updateTeamSettings)
+	s.router.handle(http.MethodGet, "{projectId}/{teamId}/_apis/work/teamsettings/iterations", s.getTeamIterations)
+	s.router.handle(http.MethodPost, "{projectId}/{teamId}/_apis/work/teamsettings/iterations", s.addTeamIteration)
+	s.router.handle(http.MethodDelete, "{projectId}/{teamId}/_apis/work/teamsettings/iterations/{iterationId}", s.removeTeamIteration)
+	s.router.handle(http.MethodGet, "{projectId}/{teamId}/_apis/work/teamsettings/teamfieldvalues", s.getTeamFieldValues)
+	s.router.handle(http.MethodPatch, "{projectId}/{teamId}/_apis/work/teamsettings/teamfieldvalues", s.updateTeamFieldValues)
+}
+
+// Private Methods
+
+func (s *Server) addTeamIteration(req *request) (any, error) {
+	p, settings, err := s.getTeamSettingsParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body work.TeamSettingsIteration
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Id == nil {
+		return nil, badRequest("The iteration id is required.")
+	}
+
+	root := s.classificationNodes[classificationNodeKey(*p.value.Id, nodeTypeIterations)]
+	node := s.findClassificationNodeByIdentifier(root, *body.Id)
+	if node == nil {
+		return nil, notFound("VS402485: The iteration with id '%s' does not exist.", body.Id.String())
+	}
+
+	for _, iteration := range settings.iterations {
+		if iteration == node {
+			return iterationResult(node), nil
+		}
+	}
+	settings.iterations = append(settings.iterations, node)
+	return iterationResult(node), nil
+}
+
+// findAreaByValue returns the area of a value of the team field, e.g. 'Project\Area 1', whose first component is the
+// name of the project.
+func (s *Server) findAreaByValue(p *project, value string) *classificationNode {
+	components := strings.Split(value, "\\")
+	if !strings.EqualFold(components[0], *p.value.Name) {
+		return nil
+	}
+
+	node := s.classificationNodes[classificationNodeKey(*p.value.Id, nodeTypeAreas)]
+	for _, name := range components[1:] {
+		if node = node.findChild(name); node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func (s *Server) findClassificationNodeByIdentifier(node *classificationNode, identifier uuid.UUID) *classificationNode {
+	if *node.value.Identifier == identifier {
+		return node
+	}
+	for _, child := range node.children {
+		if found := s.findClassificationNodeByIdentifier(child, identifier); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func (s *Server) getTeamFieldValues(req *request) (any, error) {
+	_, settings, err := s.getTeamSettingsParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return settings.teamFieldValues(), nil
+}
+
+func (s *Server) getTeamIterations(req *request) (any, error) {
+	_, settings, err := s.getTeamSettingsParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	iterations := []work.TeamSettingsIteration{}
+	for _, node := range settings.iterations {
+		iterations = append(iterations, *iterationResult(node))
+	}
+	return collection(iterations), nil
+}
+
+func (s *Server) getTeamSettings(req *request) (any, error) {
+	_, settings, err := s.getTeamSettingsParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return settings.result(), nil
+}
+
+// getTeamSettingsParam returns the project and the settings of the team referenced by the parameters of the route.
+func (s *Server) getTeamSettingsParam(req *request) (*project, *teamSettings, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, team := s.findTeam(p, req.params["teamId"])
+	if team == nil {
+		return nil, nil, s.teamNotFound(req)
+	}
+
+	if p.teamSettings == nil {
+		p.teamSettings = map[uuid.UUID]*teamSettings{}
+	}
+	settings, ok := p.teamSettings[*team.Id]
+	if !ok {
+		root := s.classificationNodes[classificationNodeKey(*p.value.Id, nodeTypeIterations)]
+		rootArea := s.classificationNodes[classificationNodeKey(*p.value.Id, nodeTypeAreas)]
+		settings = &teamSettings{
+			areas:            []teamArea{{node: rootArea}},
+			backlogIteration: root,
+			backlogVisibilities: map[string]bool{
+				"Microsoft.EpicCategory":        false,
+				"Microsoft.FeatureCategory":     true,
+				"Microsoft.RequirementCategory": true,
+			},
+			bugsBehavior:          work.BugsBehaviorAsTasks,
+			defaultArea:           rootArea,
+			defaultIterationMacro: work.DefaultIterationMacroCurrentIteration,
+			iterations:            append([]*classificationNode{}, root.children...),
+			workingDays:           []string{"monday", "tuesday", "wednesday", "thursday", "friday"},
+		}
+		p.teamSettings[*team.Id] = settings
+	}
+	return p, settings, nil
+}
+
+func (s *Server) removeTeamIteration(req *request) (any, error) {
+	_, settings, err := s.getTeamSettingsParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, node := range settings.iterations {
+		if strings.EqualFold(node.value.Identifier.String(), req.params["iterationId"]) {
+			settings.iterations = append(settings.iterations[:i], settings.iterations[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, notFound("VS402485: The team is not subscribed to the iteration with id '%s'.", req.params["iterationId"])
+}
+
+// updateTeamFieldValues replaces the areas of a team, whose default area must be one of its areas.
+func (s *Server) updateTeamFieldValues(req *request) (any, error) {
+	p, settings, err := s.getTeamSettingsParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body work.TeamFieldValuesPatch
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.DefaultValue == nil || body.Values == nil || len(*body.Values) == 0 {
+		return nil, badRequest("VS403417: The default value and the values of the team field are required.")
+	}
+
+	var areas []teamArea
+	for _, value := range *body.Values {
+		if value.Value == nil {
+			return nil, badRequest("VS403417: The values of the team field are required.")
+		}
+		node := s.findAreaByValue(p, *value.Value)
+		if node == nil {
+			return nil, badRequest("TF400499: The area path '%s' does not exist.", *value.Value)
+		}
+		areas = append(areas, teamArea{includeChildren: value.IncludeChildren != nil && *value.IncludeChildren, node: node})
+	}
+
+	defaultArea := s.findAreaByValue(p, *body.DefaultValue)
+	if defaultArea == nil {
+		return nil, badRequest("TF400499: The area path '%s' does not exist.", *body.DefaultValue)
+	}
+	isIncluded := false
+	for _, area := range areas {
+		isIncluded = isIncluded || area.node == defaultArea
+	}
+	if !isIncluded {
+		return nil, badRequest("VS403418: The default value '%s' of the team field must be one of its values.", *body.DefaultValue)
+	}
+
+	settings.areas = areas
+	settings.defaultArea = defaultArea
+	return settings.teamFieldValues(), nil
+}
+
+func (s *Server) updateTeamSettings(req *request) (any, error) {
+	p, settings, err := s.getTeamSettingsParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body work.TeamSettingsPatch
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+
+	root := s.classificationNodes[classificationNodeKey(*p.value.Id, nodeTypeIterations)]
+	var backlogIteration, defaultIteration *classificationNode
+	if body.BacklogIteration != nil {
+		if backlogIteration = s.findClassificationNodeByIdentifier(root, *body.BacklogIteration); backlogIteration == nil {
+			return nil, notFound("VS402485: The iteration with id '%s' does not exist.", body.BacklogIteration.String())
+		}
+	}
+	if body.DefaultIteration != nil {
+		if defaultIteration = s.findClassificationNodeByIdentifier(root, *body.DefaultIteration); defaultIteration == nil {
+			return nil, notFound("VS402485: The iteration with id '%s' does not exist.", body.DefaultIteration.String())
+		}
+	}
+	if body.BugsBehavior != nil && !containsFold([]string{work.BugsBehaviorAsRequirements, work.BugsBehaviorAsTasks, work.BugsBehaviorOff}, *body.BugsBehavior) {
+		return nil, badRequest("The bugs behavior '%s' is not valid.", *body.BugsBehavior)
+	}
+	if body.DefaultIterationMacro != nil && *body.DefaultIterationMacro != "" && !strings.EqualFold(*body.DefaultIterationMacro, work.DefaultIterationMacroCurrentIteration) {
+		return nil, badRequest("The default iteration macro '%s' is not valid.", *body.DefaultIterationMacro)
+	}
+	if body.WorkingDays != nil {
+		for _, day := range *body.WorkingDays {
+			if !containsFold(weekDays, day) {
+				return nil, badRequest("The working day '%s' is not valid.", day)
+			}
+		}
+	}
+
+	if backlogIteration != nil {
+		settings.backlogIteration = backlogIteration
+	}
+	if body.BacklogVisibilities != nil {
+		for category, visible := range *body.BacklogVisibilities {
+			settings.backlogVisibilities[category] = visible
+		}
+	}
+	if body.BugsBehavior != nil {
+		settings.bugsBehavior = *body.BugsBehavior
+	}
+	if defaultIteration != nil {
+		settings.defaultIteration = defaultIteration
+		settings.defaultIterationMacro = ""
+	}
+	if body.DefaultIterationMacro != nil && *body.DefaultIterationMacro != "" {
+		settings.defaultIteration = nil
+		settings.defaultIterationMacro = work.DefaultIterationMacroCurrentIteration
+	}
+	if body.WorkingDays != nil {
+		settings.workingDays = nil
+		for _, day := range *body.WorkingDays {
+			settings.workingDays = append(settings.workingDays, strings.ToLower(day))
+		}
+	}
+	return settings.result(), nil
+}
+
+// classificationNodePath returns the path of an area or an iteration in the work APIs, e.g. 'Project\Area 1', which
+// does not contain the type of the node.
+func classificationNodePath(node *classificationNode) string {
+	var names []string
+	for n := node; n != nil; n = n.parent {
+		names = append([]string{*n.value.Name}, names...)
+	}
+	return strings.Join(names, "\\")
+}
+
+// iterationResult returns the reference of an iteration in the settings of a team, e.g. 'Project\Sprint 1'.
+func iterationResult(node *classificationNode) *work.TeamSettingsIteration {
+	if node == nil {
+		return nil
+	}
+
+	return &work.TeamSettingsIteration{
+		Id:   node.value.Identifier,
+		Name: node.value.Name,
+		Path: utils.String(classificationNodePath(node)),
+	}
+}
+
+func (t *teamSettings) result() *work.TeamSetting {
+	backlogVisibilities := map[string]bool{}
+	for category, visible := range t.backlogVisibilities {
+		backlogVisibilities[category] = visible
+	}
+	workingDays := append([]string{}, t.workingDays...)
+
+	result := &work.TeamSetting{
+		BacklogIteration:    iterationResult(t.backlogIteration),
+		BacklogVisibilities: &backlogVisibilities,
+		BugsBehavior:        utils.String(t.bugsBehavior),
+		DefaultIteration:    iterationResult(t.defaultIteration),
+		WorkingDays:         &workingDays,
+	}
+	if t.defaultIterationMacro != "" {
+		result.DefaultIterationMacro = utils.String(t.defaultIterationMacro)
+	}
+	return result
+}
+
+func (t *teamSettings) teamFieldValues() *work.TeamFieldValues {
+	values := []work.TeamFieldValue{}
+	for _, area := range t.areas {
+		values = append(values, work.TeamFieldValue{IncludeChildren: utils.Bool(area.includeChildren), Value: utils.String(classificationNodePath(area.node))})
+	}
+	return &work.TeamFieldValues{
+		DefaultValue: utils.String(classificationNodePath(t.defaultArea)),
+		Field:        &work.FieldReference{ReferenceName: utils.String("System.AreaPath")},
+		Values:       &values,
+	}
+}
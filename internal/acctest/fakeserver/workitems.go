@@ -0,0 +1,365 @@
+package fakeserver
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"strings"
+)
+
+const (
+	nodeTypeAreas      = "areas"
+	nodeTypeIterations = "iterations"
+
+	structureTypeArea      = "area"
+	structureTypeIteration = "iteration"
+)
+
+// classificationNode is an area or an iteration of a project, the root node having the name of the project.
+type classificationNode struct {
+	children []*classificationNode
+	parent   *classificationNode
+	p        *project
+	value    *workitems.WorkItemClassificationNode
+}
+
+// systemFields returns the system fields of the organization, used by the work item types of all the processes.
+func systemFields() map[string]*workitems.WorkItemField {
+	fields := map[string]*workitems.WorkItemField{}
+	for _, f := range []workitems.WorkItemField{
+		{Name: utils.String("Assigned To"), ReferenceName: utils.String("System.AssignedTo"), Type: utils.String(workitems.FieldTypeIdentity), IsIdentity: utils.Bool(true)},
+		{Name: utils.String("Description"), ReferenceName: utils.String("System.Description"), Type: utils.String(workitems.FieldTypeHtml)},
+		{Name: utils.String("Priority"), ReferenceName: utils.String("Microsoft.VSTS.Common.Priority"), Type: utils.String(workitems.FieldTypeInteger)},
+		{Name: utils.String("State"), ReferenceName: utils.String("System.State"), Type: utils.String(workitems.FieldTypeString)},
+		{Name: utils.String("Title"), ReferenceName: utils.String("System.Title"), Type: utils.String(workitems.FieldTypeString)},
+	} {
+		field := f
+		field.IsDeleted = utils.Bool(false)
+		field.IsPicklist = utils.Bool(false)
+		field.ReadOnly = utils.Bool(false)
+		field.Usage = utils.String(workitems.FieldUsageWorkItem)
+		fields[strings.ToLower(*field.ReferenceName)] = &field
+	}
+	return fields
+}
+
+func (s *Server) registerWorkItemsRoutes() {
+	s.router.handle(http.MethodPost, "_apis/wit/fields", s.createField)
+	s.router.handle(http.MethodGet, "_apis/wit/fields/{fieldRefName}", s.getField)
+	s.router.handle(http.MethodDelete, "_apis/wit/fields/{fieldRefName}", s.deleteField)
+	s.router.handle(http.MethodGet, "{projectId}/_apis/wit/classificationnodes/{nodeType}/{path...}", s.getClassificationNode)
+	s.router.handle(http.MethodPost, "{projectId}/_apis/wit/classificationnodes/{nodeType}/{path...}", s.createOrMoveClassificationNode)
+	s.router.handle(http.MethodPatch, "{projectId}/_apis/wit/classificationnodes/{nodeType}/{path...}", s.updateClassificationNode)
+	s.router.handle(http.MethodDelete, "{projectId}/_apis/wit/classificationnodes/{nodeType}/{path...}", s.deleteClassificationNode)
+}
+
+// Private Methods
+
+func (s *Server) createOrMoveClassificationNode(req *request) (any, error) {
+	parent, err := s.getClassificationNodeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body workitems.WorkItemClassificationNode
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("VS402485: The name of the classification node is required.")
+	}
+	if child := parent.findChild(*body.Name); child != nil && (body.Id == nil || *child.value.Id != *body.Id) {
+		return nil, conflict("VS402371: Classification node name %s is already in use by a different child of parent classification node %s.", *body.Name, *parent.value.Name)
+	}
+
+	if body.Id == nil {
+		node := s.addClassificationNode(parent.p, parent, *parent.value.StructureType, *body.Name)
+		node.setAttributes(body.Attributes)
+		return node.result(), nil
+	}
+
+	node := s.findClassificationNodeById(parent.root(), *body.Id)
+	if node == nil || node.parent == nil {
+		return nil, notFound("VS402485: The classification node with id %d does not exist.", *body.Id)
+	}
+	for ancestor := parent; ancestor != nil; ancestor = ancestor.parent {
+		if ancestor == node {
+			return nil, badRequest("VS402373: A classification node cannot be moved under one of its children.")
+		}
+	}
+
+	node.parent.children = node.parent.removeChild(node)
+	node.parent = parent
+	parent.children = append(parent.children, node)
+	node.value.Name = body.Name
+	node.setAttributes(body.Attributes)
+	node.updatePaths()
+	return node.result(), nil
+}
+
+// createField creates a custom field of the organization, its reference name being generated from its name when not
+// set.
+func (s *Server) createField(req *request) (any, error) {
+	var body workitems.WorkItemField
+	if err := req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == nil || strings.TrimSpace(*body.Name) == "" {
+		return nil, badRequest("The name of the field is required.")
+	}
+	if body.Type == nil || *body.Type == "" {
+		return nil, badRequest("The type of the field is required.")
+	}
+
+	referenceName := utils.IfThenElse[*string](body.ReferenceName != nil && *body.ReferenceName != "", body.ReferenceName, utils.String("Custom."+strings.ReplaceAll(*body.Name, " ", "")))
+	for _, field := range s.fields {
+		if strings.EqualFold(*field.Name, *body.Name) || strings.EqualFold(*field.ReferenceName, *referenceName) {
+			return nil, conflict("TF51533: The field '%s' already exists.", *field.ReferenceName)
+		}
+	}
+	if body.PicklistId != nil {
+		if _, ok := s.picklists[*body.PicklistId]; !ok {
+			return nil, badRequest("VS402852: The picklist '%s' does not exist.", body.PicklistId)
+		}
+	}
+
+	field := &workitems.WorkItemField{
+		Description:         utils.IfThenElse[*string](body.Description != nil, body.Description, utils.EmptyString),
+		IsDeleted:           utils.Bool(false),
+		IsIdentity:          utils.Bool(*body.Type == workitems.FieldTypeIdentity),
+		IsPicklist:          utils.Bool(body.PicklistId != nil),
+		IsPicklistSuggested: utils.IfThenElse[*bool](body.IsPicklistSuggested != nil, body.IsPicklistSuggested, utils.Bool(false)),
+		Name:                body.Name,
+		PicklistId:          body.PicklistId,
+		ReadOnly:            utils.Bool(false),
+		ReferenceName:       referenceName,
+		Type:                body.Type,
+		Url:                 utils.String(s.URL + "/_apis/wit/fields/" + *referenceName),
+		Usage:               utils.IfThenElse[*string](body.Usage != nil, body.Usage, utils.String(workitems.FieldUsageWorkItem)),
+	}
+	s.fields[strings.ToLower(*referenceName)] = field
+	return field, nil
+}
+
+// createRootClassificationNodes creates the root area and the root iteration of a project, with the default
+// iterations of Azure DevOps.
+func (s *Server) createRootClassificationNodes(p *project) {
+	s.classificationNodes[classificationNodeKey(*p.value.Id, nodeTypeAreas)] = s.addClassificationNode(p, nil, structureTypeArea, *p.value.Name)
+
+	iteration := s.addClassificationNode(p, nil, structureTypeIteration, *p.value.Name)
+	for i := 1; i <= 3; i++ {
+		s.addClassificationNode(p, iteration, structureTypeIteration, fmt.Sprintf("Iteration %d", i))
+	}
+	s.classificationNodes[classificationNodeKey(*p.value.Id, nodeTypeIterations)] = iteration
+}
+
+func (s *Server) addClassificationNode(p *project, parent *classificationNode, structureType string, name string) *classificationNode {
+	node := &classificationNode{
+		p:      p,
+		parent: parent,
+		value: &workitems.WorkItemClassificationNode{
+			Id:            utils.Int(s.nextId()),
+			Identifier:    utils.UUID(uuid.NewString()),
+			Name:          utils.String(name),
+			StructureType: utils.String(structureType),
+		},
+	}
+	if parent != nil {
+		parent.children = append(parent.children, node)
+	}
+	node.updatePaths()
+	return node
+}
+
+func (s *Server) deleteClassificationNode(req *request) (any, error) {
+	node, err := s.getClassificationNodeParam(req)
+	if err != nil {
+		return nil, err
+	}
+	if node.parent == nil {
+		return nil, badRequest("VS402376: The root classification node cannot be deleted.")
+	}
+
+	node.parent.children = node.parent.removeChild(node)
+	return nil, nil
+}
+
+// deleteField deletes a custom field of the organization, which is removed from the work item types using it.
+func (s *Server) deleteField(req *request) (any, error) {
+	field := s.findField(req.params["fieldRefName"])
+	if field == nil {
+		return nil, notFound("TF51535: Cannot find field %s.", req.params["fieldRefName"])
+	}
+	if _, ok := systemFields()[strings.ToLower(*field.ReferenceName)]; ok {
+		return nil, badRequest("TF51536: The system field '%s' cannot be deleted.", *field.ReferenceName)
+	}
+
+	delete(s.fields, strings.ToLower(*field.ReferenceName))
+	s.removeFieldFromWorkItemTypes(*field.ReferenceName)
+	return nil, nil
+}
+
+func (s *Server) findClassificationNodeById(node *classificationNode, id int) *classificationNode {
+	if *node.value.Id == id {
+		return node
+	}
+	for _, child := range node.children {
+		if found := s.findClassificationNodeById(child, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func (s *Server) findField(referenceName string) *workitems.WorkItemField {
+	return s.fields[strings.ToLower(referenceName)]
+}
+
+func (s *Server) getClassificationNode(req *request) (any, error) {
+	node, err := s.getClassificationNodeParam(req)
+	if err != nil {
+		return nil, err
+	}
+	return node.result(), nil
+}
+
+// getClassificationNodeParam returns the node at the path of the route, relative to the root node of its type. The
+// path is case-insensitive and its components are separated by slashes or backslashes.
+func (s *Server) getClassificationNodeParam(req *request) (*classificationNode, error) {
+	p, err := s.getProjectParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeType := strings.ToLower(req.params["nodeType"])
+	node, ok := s.classificationNodes[classificationNodeKey(*p.value.Id, nodeType)]
+	if !ok {
+		return nil, notFound("VS402485: The classification node type '%s' does not exist.", req.params["nodeType"])
+	}
+
+	path := strings.ReplaceAll(req.params["path"], "\\", "/")
+	for _, name := range strings.Split(path, "/") {
+		if name == "" {
+			continue
+		}
+		if node = node.findChild(name); node == nil {
+			return nil, notFound("VS402485: The node name '%s' does not exist under the path '%s'.", name, path)
+		}
+	}
+	return node, nil
+}
+
+func (s *Server) getField(req *request) (any, error) {
+	field := s.findField(req.params["fieldRefName"])
+	if field == nil {
+		return nil, notFound("TF51535: Cannot find field %s.", req.params["fieldRefName"])
+	}
+	return field, nil
+}
+
+func (s *Server) updateClassificationNode(req *request) (any, error) {
+	node, err := s.getClassificationNodeParam(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body workitems.WorkItemClassificationNode
+	if err = req.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name != nil && *body.Name != "" && !strings.EqualFold(*body.Name, *node.value.Name) {
+		if node.parent == nil {
+			return nil, badRequest("VS402377: The root classification node cannot be renamed.")
+		}
+		if node.parent.findChild(*body.Name) != nil {
+			return nil, conflict("VS402371: Classification node name %s is already in use by a different child of parent classification node %s.", *body.Name, *node.parent.value.Name)
+		}
+	}
+
+	if body.Name != nil && *body.Name != "" {
+		node.value.Name = body.Name
+	}
+	node.setAttributes(body.Attributes)
+	node.updatePaths()
+	return node.result(), nil
+}
+
+func classificationNodeKey(projectId uuid.UUID, nodeType string) string {
+	return projectId.String() + "/" + nodeType
+}
+
+func (n *classificationNode) findChild(name string) *classificationNode {
+	for _, child := range n.children {
+		if strings.EqualFold(*child.value.Name, name) {
+			return child
+		}
+	}
+	return nil
+}
+
+func (n *classificationNode) removeChild(node *classificationNode) []*classificationNode {
+	var children []*classificationNode
+	for _, child := range n.children {
+		if child != node {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// result returns a copy of the node with its direct children, like the REST API called with a depth of 1.
+func (n *classificationNode) result() *workitems.WorkItemClassificationNode {
+	result := *n.value
+	result.HasChildren = utils.Bool(len(n.children) > 0)
+	if len(n.children) > 0 {
+		var children []workitems.WorkItemClassificationNode
+		for _, child := range n.children {
+			c := *child.value
+			c.HasChildren = utils.Bool(len(child.children) > 0)
+			children = append(children, c)
+		}
+		result.Children = &children
+	}
+	return &result
+}
+
+func (n *classificationNode) root() *classificationNode {
+	node := n
+	for node.parent != nil {
+		node = node.parent
+	}
+	return node
+}
+
+// setAttributes sets the attributes of an iteration, empty attributes clearing its dates.
+func (n *classificationNode) setAttributes(attributes *map[string]interface{}) {
+	if attributes == nil {
+		return
+	}
+	if len(*attributes) == 0 {
+		n.value.Attributes = nil
+		return
+	}
+	n.value.Attributes = attributes
+}
+
+// updatePaths updates the path of the node and of its descendants, e.g. '\Project\Area\Child' for an area.
+func (n *classificationNode) updatePaths() {
+	var names []string
+	for node := n; node != nil; node = node.parent {
+		names = append([]string{*node.value.Name}, names...)
+	}
+
+	structure := utils.IfThenElse[string](*n.value.StructureType == structureTypeArea, "Area", "Iteration")
+	path := "\\" + names[0] + "\\" + structure
+	if len(names) > 1 {
+		path += "\\" + strings.Join(names[1:], "\\")
+	}
+	n.value.Path = &path
+
+	for _, child := range n.children {
+		child.updatePaths()
+	}
+}
@@ -1,36 +1,132 @@
 package clients
 
 import (
+	"context"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/location"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/work"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"net/http"
+	"net/url"
 	"path"
 	"strings"
 )
 
 type AzureDevOpsClient struct {
+	azdoClient             *networking.RestClient
+	graphAvailable         bool
+	graphClient            *networking.RestClient
+	Cache                  *utils.Cache // Shared by the clients, so that an object changed by one client is not read stale by another one
 	CoreClient             *core.Client
 	GraphClient            *graph.Client
+	LocationClient         *location.Client
 	PipelinesClient        *pipelines.Client
+	ProcessesClient        *processes.Client
 	SecurityClient         *security.Client
 	ServiceEndpointsClient *serviceendpoints.Client
+	WorkClient             *work.Client
 	WorkItemsClient        *workitems.Client
 }
 
-func NewAzureDevOpsClient(organizationUrl string, authorization string, providerVersion string) *AzureDevOpsClient {
-	azdoClient := networking.NewRestClient(organizationUrl, authorization, providerVersion)
-	organizationName := path.Base(strings.TrimSuffix(organizationUrl, "/"))
-	vsspsClient := networking.NewRestClient("https://vssps.dev.azure.com/"+organizationName, authorization, providerVersion)
+type AzureDevOpsClientConfig struct {
+	ApiVersions           map[string]string // Versions of the REST APIs pinned per area, e.g. {"graph": "7.1-preview.1"}
+	GraphUrl              string            // Overrides the discovered base url of the Graph APIs
+	HttpClient            *http.Client
+	IdentityUrl           string // Overrides the discovered base url of the Identities APIs
+	MaxConcurrentRequests int    // Maximum number of requests sent at the same time to the organization, unlimited when lower than 1
+	OrganizationUrl       string
+	ProviderVersion       string
+	RetryPolicy           *networking.RetryPolicy
+	TokenProvider         networking.TokenProvider
+}
+
+func NewAzureDevOpsClient(ctx context.Context, config *AzureDevOpsClientConfig) *AzureDevOpsClient {
+	// The Graph and Identities APIs are served by other hosts, but they count towards the same organization
+	limiter := networking.GetConcurrencyLimiter(config.OrganizationUrl, config.MaxConcurrentRequests)
+	azdoClient := newRestClient(config, config.OrganizationUrl, limiter)
+	locationClient := location.NewClient(azdoClient.WithSubsystem(logger.SubsystemLocation))
+
+	graphUrl, graphAvailable := discoverGraphUrl(ctx, config, locationClient)
+	identityUrl := config.IdentityUrl
+	if identityUrl == "" {
+		identityUrl = graphUrl
+	}
+
+	// Each host negotiates the versions of the REST APIs it serves
+	graphClient := newRestClient(config, graphUrl, limiter)
+	identityClient := graphClient
+	if identityUrl != graphUrl {
+		identityClient = newRestClient(config, identityUrl, limiter)
+	}
+
+	cache := utils.NewCache()
 	return &AzureDevOpsClient{
-		CoreClient:             core.NewClient(azdoClient),
-		GraphClient:            graph.NewClient(vsspsClient),
-		PipelinesClient:        pipelines.NewClient(azdoClient),
-		SecurityClient:         security.NewClient(azdoClient, vsspsClient),
-		ServiceEndpointsClient: serviceendpoints.NewClient(azdoClient),
-		WorkItemsClient:        workitems.NewClient(azdoClient),
+		azdoClient:             azdoClient,
+		graphAvailable:         graphAvailable,
+		graphClient:            graphClient,
+		Cache:                  cache,
+		CoreClient:             core.NewClient(azdoClient.WithSubsystem(logger.SubsystemCore)),
+		GraphClient:            graph.NewClient(graphClient.WithSubsystem(logger.SubsystemGraph), identityClient.WithSubsystem(logger.SubsystemGraph), graphAvailable, cache),
+		LocationClient:         locationClient,
+		PipelinesClient:        pipelines.NewClient(azdoClient.WithSubsystem(logger.SubsystemPipelines)),
+		ProcessesClient:        processes.NewClient(azdoClient.WithSubsystem(logger.SubsystemProcesses)),
+		SecurityClient:         security.NewClient(azdoClient.WithSubsystem(logger.SubsystemSecurity), identityClient.WithSubsystem(logger.SubsystemSecurity), cache),
+		ServiceEndpointsClient: serviceendpoints.NewClient(azdoClient.WithSubsystem(logger.SubsystemServiceEndpoints)),
+		WorkClient:             work.NewClient(azdoClient.WithSubsystem(logger.SubsystemWork)),
+		WorkItemsClient:        workitems.NewClient(azdoClient.WithSubsystem(logger.SubsystemWorkItems)),
+	}
+}
+
+// Private Methods
+
+// discoverGraphUrl returns the base url of the Graph APIs and whether the Graph REST surface is available. Azure
+// DevOps Services exposes it on a dedicated host (vssps), Azure DevOps Server collections don't expose it at all.
+func discoverGraphUrl(ctx context.Context, config *AzureDevOpsClientConfig, locationClient *location.Client) (string, bool) {
+	if config.GraphUrl != "" {
+		return config.GraphUrl, true
+	}
+
+	resourceArea, err := locationClient.GetResourceArea(ctx, location.ResourceAreaIdGraph)
+	if err == nil && resourceArea != nil && resourceArea.LocationUrl != nil && *resourceArea.LocationUrl != "" {
+		return *resourceArea.LocationUrl, true
 	}
+
+	if organizationName, ok := getAzureDevOpsServicesOrganization(config.OrganizationUrl); ok {
+		return "https://vssps.dev.azure.com/" + organizationName, true
+	}
+
+	if err != nil {
+		logger.Warn(ctx, "Unable to discover the Graph APIs, falling back to the Identities APIs: "+err.Error())
+	}
+	return config.OrganizationUrl, false
+}
+
+func getAzureDevOpsServicesOrganization(organizationUrl string) (string, bool) {
+	u, err := url.Parse(organizationUrl)
+	if err != nil {
+		return "", false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "dev.azure.com" {
+		return path.Base(strings.TrimSuffix(u.Path, "/")), true
+	}
+	if strings.HasSuffix(host, ".visualstudio.com") {
+		return strings.TrimSuffix(host, ".visualstudio.com"), true
+	}
+	return "", false
+}
+
+func newRestClient(config *AzureDevOpsClientConfig, baseUrl string, limiter *networking.ConcurrencyLimiter) *networking.RestClient {
+	return networking.NewRestClient(config.HttpClient, baseUrl, config.TokenProvider, config.ProviderVersion, config.RetryPolicy).
+		WithLimiter(limiter).
+		WithApiVersionNegotiation(config.ApiVersions)
 }
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"github.com/ahmetb/go-linq/v3"
 	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"golang.org/x/exp/maps"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 )
@@ -16,6 +19,7 @@ import (
 const (
 	pathApis               = "_apis"
 	pathFeatureManagement  = "FeatureManagement"
+	pathFeatures           = "Features"
 	pathFeatureStates      = "FeatureStates"
 	pathFeatureStatesQuery = "FeatureStatesQuery"
 	pathHost               = "host"
@@ -24,7 +28,9 @@ const (
 	pathProcesses          = "processes"
 	pathProject            = "project"
 	pathProjects           = "projects"
+	pathProperties         = "properties"
 	pathTeams              = "teams"
+	pathWork               = "work"
 )
 
 type Client struct {
@@ -66,6 +72,7 @@ func (c *Client) CreateTeam(ctx context.Context, projectId string, name string,
 	return team, err
 }
 
+// DeleteProject moves a project to the recycle bin, or deletes it permanently when it is already in the recycle bin.
 func (c *Client) DeleteProject(ctx context.Context, projectId string) (*OperationReference, error) {
 	pathSegments := []string{pathApis, pathProjects, projectId}
 	operation, _, err := networking.DeleteJSON[OperationReference](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
@@ -78,6 +85,21 @@ func (c *Client) DeleteTeam(ctx context.Context, projectId string, teamId string
 	return err
 }
 
+// FindDeletedProject returns the project of the recycle bin with the given name, or nil when there is none.
+func (c *Client) FindDeletedProject(ctx context.Context, name string) (*TeamProjectReference, error) {
+	projects, err := c.GetProjects(ctx, ProjectStateDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range *projects {
+		if project.Name != nil && strings.EqualFold(*project.Name, name) {
+			return &project, nil
+		}
+	}
+	return nil, nil
+}
+
 func (c *Client) GetOperation(ctx context.Context, id string, pluginId *uuid.UUID) (*Operation, error) {
 	pathSegments := []string{pathApis, pathOperations, id}
 	queryParams := url.Values{}
@@ -113,16 +135,46 @@ func (c *Client) GetProject(ctx context.Context, id string) (*TeamProject, error
 	return project, err
 }
 
-func (c *Client) GetProjectFeatures(ctx context.Context, projectId string) (*ContributedFeatureStateQuery, error) {
-	pathSegments := []string{pathApis, pathFeatureManagement, pathFeatureStatesQuery, pathHost, pathProject, projectId}
-	body := &ContributedFeatureStateQuery{
-		FeatureIds: &[]string{
+// GetProjectId resolves the ID of a project from either its ID or its name.
+func (c *Client) GetProjectId(ctx context.Context, nameOrId string) (string, error) {
+	if _, err := uuid.Parse(nameOrId); err == nil {
+		return nameOrId, nil
+	}
+
+	project, err := c.GetProject(ctx, nameOrId)
+	if err != nil {
+		return "", err
+	}
+
+	return project.Id.String(), nil
+}
+
+// GetFeatures returns the catalogue of the features contributed to the organization, whatever their scopes.
+func (c *Client) GetFeatures(ctx context.Context) (*[]ContributedFeature, error) {
+	pathSegments := []string{pathApis, pathFeatureManagement, pathFeatures}
+	features, _, err := networking.GetJSON[ContributedFeatureCollection](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70Preview1)
+	if err != nil {
+		return nil, err
+	}
+
+	return features.Value, nil
+}
+
+// GetProjectFeatures returns the states of features in a project, the services of the project when no feature is given.
+func (c *Client) GetProjectFeatures(ctx context.Context, projectId string, featureIds []string) (*ContributedFeatureStateQuery, error) {
+	if len(featureIds) == 0 {
+		featureIds = []string{
 			ProjectFeatureBoards,
 			ProjectFeatureRepositories,
 			ProjectFeaturePipelines,
 			ProjectFeatureTestPlans,
 			ProjectFeatureArtifacts,
-		},
+		}
+	}
+
+	pathSegments := []string{pathApis, pathFeatureManagement, pathFeatureStatesQuery, pathHost, pathProject, projectId}
+	body := &ContributedFeatureStateQuery{
+		FeatureIds: &featureIds,
 		ScopeValues: &map[string]string{
 			"project": projectId,
 		},
@@ -131,22 +183,31 @@ func (c *Client) GetProjectFeatures(ctx context.Context, projectId string) (*Con
 	return featureStates, err
 }
 
-func (c *Client) GetProjects(ctx context.Context, state string, continuationToken string) (*[]TeamProjectReference, string, error) {
-	pathSegments := []string{pathApis, pathProjects}
-	queryParams := url.Values{"$top": []string{"100"}}
-	if state != "" {
-		queryParams.Add("stateFilter", state)
-	}
-	if continuationToken != "" {
-		queryParams.Add("continuationToken", continuationToken)
+// GetProjectProperties returns the properties of a project, only the ones with the given keys when there are some.
+func (c *Client) GetProjectProperties(ctx context.Context, projectId string, keys []string) (*[]ProjectProperty, error) {
+	pathSegments := []string{pathApis, pathProjects, projectId, pathProperties}
+	queryParams := url.Values{}
+	if len(keys) > 0 {
+		queryParams.Add("keys", strings.Join(keys, ","))
 	}
-	projects, resp, err := networking.GetJSON[TeamProjectReferenceCollection](c.restClient, ctx, pathSegments, queryParams, networking.ApiVersion70)
+	properties, _, err := networking.GetJSON[ProjectPropertyCollection](c.restClient, ctx, pathSegments, queryParams, networking.ApiVersion71Preview1)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
+	return properties.Value, nil
+}
 
-	continuationToken = resp.Header.Get(networking.HeaderKeyContinuationToken)
-	return projects.Value, continuationToken, err
+func (c *Client) GetProjects(ctx context.Context, state string) (*[]TeamProjectReference, error) {
+	config := networking.PaginatorConfig{
+		ApiVersion:   networking.ApiVersion70,
+		PageSize:     100,
+		PathSegments: []string{pathApis, pathProjects},
+		QueryParams:  url.Values{},
+	}
+	if state != "" {
+		config.QueryParams.Add("stateFilter", state)
+	}
+	return networking.GetAllPages[TeamProjectReference](c.restClient, ctx, config)
 }
 
 func (c *Client) GetTeam(ctx context.Context, projectId string, id string) (*WebApiTeam, error) {
@@ -156,32 +217,79 @@ func (c *Client) GetTeam(ctx context.Context, projectId string, id string) (*Web
 }
 
 func (c *Client) GetTeams(ctx context.Context, projectId string) (*[]WebApiTeam, error) {
-	pathSegments := []string{pathApis, pathProjects, projectId, pathTeams}
-	teams, _, err := networking.GetJSON[WebApiTeamCollection](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	config := networking.PaginatorConfig{
+		ApiVersion:   networking.ApiVersion70,
+		Mode:         networking.PagingModeTopSkip,
+		PathSegments: []string{pathApis, pathProjects, projectId, pathTeams},
+	}
+	return networking.GetAllPages[WebApiTeam](c.restClient, ctx, config)
+}
+
+// MigrateProjectProcess changes the process of a project. Azure DevOps only migrates projects between the inherited
+// processes of a same system process, and between such a process and its system process.
+func (c *Client) MigrateProjectProcess(ctx context.Context, projectId string, processTypeId string) (*ProcessMigrationResultModel, error) {
+	typeId, err := uuid.Parse(processTypeId)
 	if err != nil {
 		return nil, err
 	}
 
-	return teams.Value, err
+	pathSegments := []string{pathApis, pathWork, pathProcesses, projectId}
+	body := ProcessIdModel{TypeId: &typeId}
+	result, _, err := networking.PostJSON[ProcessMigrationResultModel](c.restClient, ctx, pathSegments, nil, body, networking.ApiVersion71Preview1)
+	return result, err
 }
 
-func (c *Client) OperationStateChangeConf(ctx context.Context, client *Client, operation *OperationReference) *utils.StateChangeConf {
+func (c *Client) OperationStateChangeConf(ctx context.Context, client *Client, operation *OperationReference, timeout time.Duration) *utils.StateChangeConf {
 	return &utils.StateChangeConf{
 		Delay:      5 * time.Second,
 		MinTimeout: 5 * time.Second,
 		Pending:    []string{"inProgress", "queued", "notSet"},
 		Target:     []string{"failed", "succeeded", "cancelled"},
 		Refresh:    client.operationStatusRefreshFunc(ctx, client, operation),
-		Timeout:    2 * time.Minute,
+		Timeout:    timeout,
+	}
+}
+
+// RestoreProject restores a project from the recycle bin, along with all its content.
+func (c *Client) RestoreProject(ctx context.Context, projectId string) (*OperationReference, error) {
+	pathSegments := []string{pathApis, pathProjects, projectId}
+	state := ProjectState(ProjectStateWellFormed)
+	body := TeamProjectReference{State: &state}
+	operation, _, err := networking.PatchJSON[OperationReference](c.restClient, ctx, pathSegments, nil, body, networking.ApiVersion70)
+	return operation, err
+}
+
+// SetProjectProperties adds or replaces the given properties of a project, and removes the properties of removedKeys.
+func (c *Client) SetProjectProperties(ctx context.Context, projectId string, properties map[string]string, removedKeys []string) error {
+	var body []JsonPatchOperation
+	for _, key := range removedKeys {
+		body = append(body, JsonPatchOperation{Op: "remove", Path: getJsonPatchPath(key)})
+	}
+	keys := maps.Keys(properties)
+	sort.Strings(keys)
+	for _, key := range keys {
+		body = append(body, JsonPatchOperation{Op: "add", Path: getJsonPatchPath(key), Value: properties[key]})
 	}
+	if len(body) == 0 {
+		return nil
+	}
+
+	pathSegments := []string{pathApis, pathProjects, projectId, pathProperties}
+	_, _, err := networking.PatchJSONSpecialContentType[networking.NoJSON](c.restClient, ctx, pathSegments, nil, body, networking.ApiVersion71Preview1)
+	return err
 }
 
-func (c *Client) UpdateProject(ctx context.Context, projectId string, name string, description string) (*OperationReference, error) {
+// UpdateProject updates the name, the description and the visibility of a project, the empty name and visibility
+// being left unchanged.
+func (c *Client) UpdateProject(ctx context.Context, projectId string, name string, description string, visibility string) (*OperationReference, error) {
 	pathSegments := []string{pathApis, pathProjects, projectId}
 	project := TeamProject{Description: &description}
 	if name != "" {
 		project.Name = &name
 	}
+	if visibility != "" {
+		project.Visibility = &visibility
+	}
 	operation, _, err := networking.PatchJSON[OperationReference](c.restClient, ctx, pathSegments, nil, project, networking.ApiVersion70)
 	return operation, err
 }
@@ -191,7 +299,7 @@ func (c *Client) UpdateProjectFeature(ctx context.Context, projectId string, fea
 	body := &ContributedFeatureState{
 		FeatureId: &featureId,
 		Scope: &ContributedFeatureSettingScope{
-			SettingScope: utils.String("project"),
+			SettingScope: utils.String(FeatureSettingScopeProject),
 			UserScoped:   utils.Bool(false),
 		},
 		State: &state,
@@ -215,6 +323,11 @@ func (c *Client) UpdateTeam(ctx context.Context, projectId string, teamId string
 func (c *Client) operationStatusRefreshFunc(ctx context.Context, client *Client, operation *OperationReference) utils.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		pendingOperation, err := client.GetOperation(ctx, operation.Id.String(), operation.PluginId)
+		if networking.IsRetryable(err) {
+			// The operation goes on server side, its status is polled again until the timeout
+			logger.Warn(ctx, "Unable to retrieve the status of operation '"+operation.Id.String()+"': "+err.Error())
+			return &Operation{Id: operation.Id, PluginId: operation.PluginId}, "notSet", nil
+		}
 		if err != nil {
 			return nil, "failed", err
 		}
@@ -222,3 +335,8 @@ func (c *Client) operationStatusRefreshFunc(ctx context.Context, client *Client,
 		return pendingOperation, *pendingOperation.Status, err
 	}
 }
+
+// getJsonPatchPath escapes a key into the path of a JSON patch operation, as defined by RFC 6901.
+func getJsonPatchPath(key string) string {
+	return "/" + strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -10,13 +10,36 @@ const (
 	CapabilitiesProcessTemplateTypeId = "templateTypeId"
 	CapabilitiesVersionControl        = "versioncontrol"
 	CapabilitiesVersionControlType    = "sourceControlType"
+	FeatureSettingScopeProject        = "project"
+	FeatureStateUndefined             = "undefined"
 	ProjectFeatureArtifacts           = "ms.azure-artifacts.feature"
 	ProjectFeatureBoards              = "ms.vss-work.agile"
 	ProjectFeaturePipelines           = "ms.vss-build.pipelines"
 	ProjectFeatureRepositories        = "ms.vss-code.version-control"
 	ProjectFeatureTestPlans           = "ms.vss-test-web.test"
+	ProjectStateDeleted               = "deleted"
+	ProjectStateWellFormed            = "wellFormed"
+
+	// Properties set by Azure DevOps, which cannot be managed
+	ProjectPropertySystemPrefix = "System."
+
+	// Deleted projects are kept in the recycle bin for 28 days before being deleted permanently
+	ProjectRetentionDays = 28
 )
 
+type ContributedFeature struct {
+	DefaultState *bool                             `json:"defaultState,omitempty"`
+	Description  *string                           `json:"description,omitempty"`
+	Id           *string                           `json:"id,omitempty"`
+	Name         *string                           `json:"name,omitempty"`
+	Scopes       *[]ContributedFeatureSettingScope `json:"scopes,omitempty"`
+}
+
+type ContributedFeatureCollection struct {
+	Count *int                  `json:"count"`
+	Value *[]ContributedFeature `json:"value"`
+}
+
 type ContributedFeatureSettingScope struct {
 	SettingScope *string `json:"settingScope,omitempty"`
 	UserScoped   *bool   `json:"userScoped,omitempty"`
@@ -115,6 +138,25 @@ type ProcessCollection struct {
 	Value *[]Process `json:"value"`
 }
 
+type ProcessIdModel struct {
+	TypeId *uuid.UUID `json:"typeId,omitempty"`
+}
+
+type ProcessMigrationResultModel struct {
+	ProcessId *uuid.UUID `json:"processId,omitempty"`
+	ProjectId *uuid.UUID `json:"projectId,omitempty"`
+}
+
+type ProjectProperty struct {
+	Name  *string     `json:"name,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type ProjectPropertyCollection struct {
+	Count *int               `json:"count"`
+	Value *[]ProjectProperty `json:"value"`
+}
+
 type ProjectReference struct {
 	Id   *uuid.UUID `json:"id,omitempty"`
 	Name *string    `json:"name,omitempty"`
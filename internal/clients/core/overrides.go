@@ -36,3 +36,7 @@ func (t *Time) UnmarshalJSON(b []byte) error {
 	t.Time = t2
 	return err
 }
+
+func (t *Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Time)
+}
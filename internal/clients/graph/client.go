@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/patrickmn/go-cache"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"net/url"
@@ -14,8 +14,7 @@ import (
 )
 
 const (
-	queryContinuationToken = "continuationToken"
-	queryScopeDescriptor   = "scopeDescriptor"
+	queryScopeDescriptor = "scopeDescriptor"
 
 	pathApis           = "_apis"
 	pathDescriptors    = "descriptors"
@@ -27,19 +26,31 @@ const (
 	pathUsers          = "users"
 )
 
+// ErrGraphNotSupported is returned by the calls which have no equivalent in the Identities APIs, when the organization
+// or collection does not expose the Graph APIs.
+var ErrGraphNotSupported = errors.New("the Graph APIs are not available on this server")
+
 type Client struct {
-	cache       *cache.Cache
-	vsspsClient *networking.RestClient
+	cache          *utils.Cache
+	graphAvailable bool
+	identityClient *networking.RestClient
+	vsspsClient    *networking.RestClient
 }
 
-func NewClient(vsspsClient *networking.RestClient) *Client {
+func NewClient(vsspsClient *networking.RestClient, identityClient *networking.RestClient, graphAvailable bool, cache *utils.Cache) *Client {
 	return &Client{
-		cache:       cache.New(cache.NoExpiration, 0),
-		vsspsClient: vsspsClient,
+		cache:          cache,
+		graphAvailable: graphAvailable,
+		identityClient: identityClient,
+		vsspsClient:    vsspsClient,
 	}
 }
 
 func (c *Client) CreateGroup(ctx context.Context, projectId string, name string, description string) (*GraphGroup, error) {
+	if err := c.requireGraph("Creating a group"); err != nil {
+		return nil, err
+	}
+
 	descriptor, err := c.getProjectDescriptor(ctx, projectId)
 	if err != nil {
 		return nil, err
@@ -52,25 +63,40 @@ func (c *Client) CreateGroup(ctx context.Context, projectId string, name string,
 	pathSegments := []string{pathApis, pathGraph, pathGroups}
 	queryParams := url.Values{queryScopeDescriptor: []string{*descriptor}}
 	group, _, err := networking.PostJSON[GraphGroup](c.vsspsClient, ctx, pathSegments, queryParams, body, networking.ApiVersion70Preview1)
-	return group, err
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(utils.CacheKindGroupDescriptor, group.Descriptor, projectId, name)
+	return group, nil
 }
 
 func (c *Client) CreateGroupByOriginId(ctx context.Context, originId string) (*GraphGroup, error) {
+	if err := c.requireGraph("Materializing a group"); err != nil {
+		return nil, err
+	}
+
 	body := GraphGroupOriginIdCreationContext{
 		OriginId: &originId,
 	}
 	pathSegments := []string{pathApis, pathGraph, pathGroups}
 	group, _, err := networking.PostJSON[GraphGroup](c.vsspsClient, ctx, pathSegments, nil, body, networking.ApiVersion70Preview1)
-	return group, err
+	if err != nil {
+		return nil, err
+	}
+
+	// The identity picker returned the group without a subject descriptor before it was materialized
+	c.cache.Invalidate(ctx, utils.CacheKindIdentityPicker)
+	return group, nil
 }
 
-func (c *Client) CreateGroupMemberships(ctx context.Context, projectId string, groupName string, members []string) (*[]GraphMembership, error) {
-	groupDescriptor, err := c.getGroupDescriptor(ctx, projectId, groupName)
+func (c *Client) CreateGroupMemberships(ctx context.Context, projectId string, groupName string, members []string, timeout time.Duration) (*[]GraphMembership, error) {
+	groupDescriptor, err := c.GetGroupDescriptor(ctx, projectId, groupName)
 	if err != nil {
 		return nil, err
 	}
 
-	memberDescriptors, err := c.getMemberDescriptors(ctx, members)
+	memberDescriptors, err := c.GetMemberDescriptors(ctx, members)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +108,7 @@ func (c *Client) CreateGroupMemberships(ctx context.Context, projectId string, g
 		}
 	}
 
-	stateConf := c.membershipsStateChangeConf(ctx, projectId, groupName, memberDescriptors)
+	stateConf := c.membershipsStateChangeConf(ctx, *groupDescriptor, groupName, memberDescriptors, &[]string{}, timeout)
 	memberships, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return nil, err
@@ -92,8 +118,13 @@ func (c *Client) CreateGroupMemberships(ctx context.Context, projectId string, g
 }
 
 func (c *Client) DeleteGroup(ctx context.Context, descriptor string) error {
+	if err := c.requireGraph("Deleting a group"); err != nil {
+		return err
+	}
+
 	pathSegments := []string{pathApis, pathGraph, pathGroups, descriptor}
 	_, _, err := networking.DeleteJSON[networking.NoJSON](c.vsspsClient, ctx, pathSegments, nil, networking.ApiVersion70Preview1)
+	c.invalidateGroups(ctx)
 	return err
 }
 
@@ -113,62 +144,89 @@ func (c *Client) DeleteGroupMemberships(ctx context.Context, projectId string, g
 	return nil
 }
 
+func (c *Client) FindGroup(ctx context.Context, projectId string, predicate func(group *GraphGroup) bool) (*GraphGroup, error) {
+	if !c.graphAvailable {
+		groups, err := c.getGroupsFromIdentities(ctx, projectId)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range *groups {
+			if predicate(&group) {
+				return &group, nil
+			}
+		}
+		return nil, nil
+	}
+
+	config, err := c.getPaginatorConfig(ctx, projectId, pathGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *GraphGroup
+	err = networking.ForEach[GraphGroup](c.vsspsClient, ctx, *config, func(group *GraphGroup) bool {
+		if predicate(group) {
+			found = group
+		}
+		return found == nil
+	})
+	return found, err
+}
+
 func (c *Client) GetGroup(ctx context.Context, descriptor string) (*GraphGroup, error) {
+	if err := c.requireGraph("Reading a group by descriptor"); err != nil {
+		return nil, err
+	}
+
 	pathSegments := []string{pathApis, pathGraph, pathGroups, descriptor}
 	group, _, err := networking.GetJSON[GraphGroup](c.vsspsClient, ctx, pathSegments, nil, networking.ApiVersion70Preview1)
 	return group, err
 }
 
-func (c *Client) GetGroupMemberships(ctx context.Context, projectId string, name string) (*[]GraphMembership, error) {
-	groupDescriptor, err := c.getGroupDescriptor(ctx, projectId, name)
-	if err != nil {
-		return nil, err
+func (c *Client) GetGroupDescriptor(ctx context.Context, projectId string, name string) (*string, error) {
+	if p, ok := c.cache.Get(ctx, utils.CacheKindGroupDescriptor, projectId, name); ok {
+		return p.(*string), nil
 	}
 
-	pathSegments := []string{pathApis, pathGraph, pathMemberships, *groupDescriptor}
-	queryParams := url.Values{"direction": []string{"down"}}
-	memberships, _, err := networking.GetJSON[GraphMembershipCollection](c.vsspsClient, ctx, pathSegments, queryParams, networking.ApiVersion70Preview1)
+	group, err := c.FindGroup(ctx, projectId, func(group *GraphGroup) bool {
+		return strings.EqualFold(*group.DisplayName, name) || strings.EqualFold(*group.PrincipalName, name)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return memberships.Value, nil
+	if group == nil {
+		return nil, errors.New(fmt.Sprintf("Group with name '%s' in project '%s' not found", name, projectId))
+	}
+
+	c.cache.Set(utils.CacheKindGroupDescriptor, group.Descriptor, projectId, name)
+	return group.Descriptor, nil
 }
 
-func (c *Client) GetGroups(ctx context.Context, projectId string, continuationToken string) (*[]GraphGroup, error) {
-	projectDescriptor, err := c.getProjectDescriptor(ctx, projectId)
+func (c *Client) GetGroupMemberships(ctx context.Context, projectId string, name string) (*[]GraphMembership, error) {
+	groupDescriptor, err := c.GetGroupDescriptor(ctx, projectId, name)
 	if err != nil {
 		return nil, err
 	}
 
-	pathSegments := []string{pathApis, pathGraph, pathGroups}
-	queryParams := url.Values{queryScopeDescriptor: []string{*projectDescriptor}}
-	if continuationToken != "" {
-		queryParams.Add(queryContinuationToken, continuationToken)
-	}
-
-	var groups []GraphGroup
-	hasMore := true
-	for hasMore {
-		collection, resp, err := networking.GetJSON[GraphGroupCollection](c.vsspsClient, ctx, pathSegments, queryParams, networking.ApiVersion70Preview1)
-		if err != nil {
-			return nil, err
-		}
+	return c.getMemberships(ctx, *groupDescriptor)
+}
 
-		for _, group := range *collection.Value {
-			groups = append(groups, group)
-		}
+func (c *Client) GetGroups(ctx context.Context, projectId string) (*[]GraphGroup, error) {
+	if !c.graphAvailable {
+		return c.getGroupsFromIdentities(ctx, projectId)
+	}
 
-		continuationToken = resp.Header.Get(networking.HeaderKeyContinuationToken)
-		hasMore = continuationToken != ""
+	config, err := c.getPaginatorConfig(ctx, projectId, pathGroups)
+	if err != nil {
+		return nil, err
 	}
 
-	return &groups, nil
+	return networking.GetAllPages[GraphGroup](c.vsspsClient, ctx, *config)
 }
 
 func (c *Client) GetIdentityPickerIdentity(ctx context.Context, query string) (*IdentityPickerIdentity, error) {
-	cacheKey := utils.GetCacheKey("IdentityPicker", query)
-	if i, ok := c.cache.Get(cacheKey); ok {
+	if i, ok := c.cache.Get(ctx, utils.CacheKindIdentityPicker, query); ok {
 		return i.(*IdentityPickerIdentity), nil
 	}
 
@@ -199,68 +257,164 @@ func (c *Client) GetIdentityPickerIdentity(ctx context.Context, query string) (*
 	}
 
 	identity := (*result.Identities)[0]
-	c.cache.Set(cacheKey, &identity, cache.NoExpiration)
+	c.cache.Set(utils.CacheKindIdentityPicker, &identity, query)
 	return &identity, nil
 }
 
+// GetMemberDescriptors resolves users and groups to their subject descriptors with the identity picker, the
+// identities which were never materialized in the organization being created first.
+func (c *Client) GetMemberDescriptors(ctx context.Context, members []string) (*[]string, error) {
+	var memberDescriptors []string
+	for _, member := range members {
+		identity, err := c.GetIdentityPickerIdentity(ctx, member)
+		if err != nil {
+			return nil, err
+		}
+
+		memberDescriptor := identity.SubjectDescriptor
+		if memberDescriptor == nil {
+			switch strings.ToLower(*identity.EntityType) {
+			case "group":
+				group, err := c.CreateGroupByOriginId(ctx, *identity.OriginId)
+				if err != nil {
+					return nil, err
+				}
+
+				if group.Descriptor == nil {
+					return nil, errors.New(fmt.Sprintf("Unable to find identity descriptor for '%s'", member))
+				}
+
+				memberDescriptor = group.Descriptor
+			case "user":
+				user, err := c.CreateUserByOriginId(ctx, *identity.OriginId)
+				if err != nil {
+					return nil, err
+				}
+
+				if user.Descriptor == nil {
+					return nil, errors.New(fmt.Sprintf("Unable to find identity descriptor for '%s'", member))
+				}
+
+				memberDescriptor = user.Descriptor
+			default:
+				return nil, errors.New(fmt.Sprintf("Unknown entity type '%s'", *identity.EntityType))
+			}
+		}
+
+		memberDescriptors = append(memberDescriptors, *memberDescriptor)
+	}
+
+	return &memberDescriptors, nil
+}
+
+// GetTeamDescriptor returns the subject descriptor of the security group backing a team, which has the ID of the team.
+func (c *Client) GetTeamDescriptor(ctx context.Context, teamId string) (*string, error) {
+	if err := c.requireGraph("Resolving the descriptor of a team"); err != nil {
+		return nil, err
+	}
+
+	if p, ok := c.cache.Get(ctx, utils.CacheKindGroupDescriptor, teamId); ok {
+		return p.(*string), nil
+	}
+
+	pathSegments := []string{pathApis, pathGraph, pathDescriptors, teamId}
+	result, _, err := networking.GetJSON[GraphDescriptorResult](c.vsspsClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(utils.CacheKindGroupDescriptor, result.Value, teamId)
+	return result.Value, nil
+}
+
+func (c *Client) GetTeamMemberships(ctx context.Context, teamId string) (*[]GraphMembership, error) {
+	teamDescriptor, err := c.GetTeamDescriptor(ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getMemberships(ctx, *teamDescriptor)
+}
+
+func (c *Client) FindUser(ctx context.Context, projectId string, predicate func(user *GraphUser) bool) (*GraphUser, error) {
+	if err := c.requireGraph("Listing users"); err != nil {
+		return nil, err
+	}
+
+	config, err := c.getPaginatorConfig(ctx, projectId, pathUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *GraphUser
+	err = networking.ForEach[GraphUser](c.vsspsClient, ctx, *config, func(user *GraphUser) bool {
+		if predicate(user) {
+			found = user
+		}
+		return found == nil
+	})
+	return found, err
+}
+
 func (c *Client) GetUser(ctx context.Context, descriptor string) (*GraphUser, error) {
+	if err := c.requireGraph("Reading a user"); err != nil {
+		return nil, err
+	}
+
 	pathSegments := []string{pathApis, pathGraph, pathUsers, descriptor}
 	user, _, err := networking.GetJSON[GraphUser](c.vsspsClient, ctx, pathSegments, nil, networking.ApiVersion70Preview1)
 	return user, err
 }
 
 func (c *Client) CreateUserByOriginId(ctx context.Context, originId string) (*GraphUser, error) {
+	if err := c.requireGraph("Materializing a user"); err != nil {
+		return nil, err
+	}
+
 	body := GraphUserOriginIdCreationContext{
 		OriginId: &originId,
 	}
 	pathSegments := []string{pathApis, pathGraph, pathUsers}
 	user, _, err := networking.PostJSON[GraphUser](c.vsspsClient, ctx, pathSegments, nil, body, networking.ApiVersion70Preview1)
-	return user, err
-}
-
-func (c *Client) GetUsers(ctx context.Context, projectId string, continuationToken string) (*[]GraphUser, error) {
-	projectDescriptor, err := c.getProjectDescriptor(ctx, projectId)
 	if err != nil {
 		return nil, err
 	}
 
-	pathSegments := []string{pathApis, pathGraph, pathUsers}
-	queryParams := url.Values{queryScopeDescriptor: []string{*projectDescriptor}}
-	if continuationToken != "" {
-		queryParams.Add(queryContinuationToken, continuationToken)
-	}
-
-	var users []GraphUser
-	hasMore := true
-	for hasMore {
-		collection, resp, err := networking.GetJSON[GraphUserCollection](c.vsspsClient, ctx, pathSegments, queryParams, networking.ApiVersion70Preview1)
-		if err != nil {
-			return nil, err
-		}
+	// The identity picker returned the user without a subject descriptor before it was materialized
+	c.cache.Invalidate(ctx, utils.CacheKindIdentityPicker)
+	return user, nil
+}
 
-		for _, user := range *collection.Value {
-			users = append(users, user)
-		}
+func (c *Client) GetUsers(ctx context.Context, projectId string) (*[]GraphUser, error) {
+	if err := c.requireGraph("Listing users"); err != nil {
+		return nil, err
+	}
 
-		continuationToken = resp.Header.Get(networking.HeaderKeyContinuationToken)
-		hasMore = continuationToken != ""
+	config, err := c.getPaginatorConfig(ctx, projectId, pathUsers)
+	if err != nil {
+		return nil, err
 	}
 
-	return &users, nil
+	return networking.GetAllPages[GraphUser](c.vsspsClient, ctx, *config)
 }
 
 func (c *Client) UpdateGroup(ctx context.Context, descriptor string, displayName string, description string) (*GraphGroup, error) {
+	if err := c.requireGraph("Updating a group"); err != nil {
+		return nil, err
+	}
+
 	pathSegments := []string{pathApis, pathGraph, pathGroups, descriptor}
 	body := []core.JsonPatchOperation{
 		{Op: "replace", Path: "/description", Value: description},
 		{Op: "replace", Path: "/displayName", Value: displayName},
 	}
 	group, _, err := networking.PatchJSONSpecialContentType[GraphGroup](c.vsspsClient, ctx, pathSegments, nil, body, networking.ApiVersion70Preview1)
+	c.invalidateGroups(ctx)
 	return group, err
 }
 
-func (c *Client) UpdateGroupMemberships(ctx context.Context, projectId string, groupName string, members []string) (*[]GraphMembership, error) {
-	groupDescriptor, err := c.getGroupDescriptor(ctx, projectId, groupName)
+func (c *Client) UpdateGroupMemberships(ctx context.Context, projectId string, groupName string, members []string, timeout time.Duration) (*[]GraphMembership, error) {
+	groupDescriptor, err := c.GetGroupDescriptor(ctx, projectId, groupName)
 	if err != nil {
 		return nil, err
 	}
@@ -275,7 +429,7 @@ func (c *Client) UpdateGroupMemberships(ctx context.Context, projectId string, g
 		currentDescriptors = append(currentDescriptors, *membership.MemberDescriptor)
 	}
 
-	membersDescriptors, err := c.getMemberDescriptors(ctx, members)
+	membersDescriptors, err := c.GetMemberDescriptors(ctx, members)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +450,38 @@ func (c *Client) UpdateGroupMemberships(ctx context.Context, projectId string, g
 		}
 	}
 
-	stateConf := c.membershipsStateChangeConf(ctx, projectId, groupName, membersDescriptors)
+	stateConf := c.membershipsStateChangeConf(ctx, *groupDescriptor, groupName, membersDescriptors, membersToDelete, timeout)
+	memberships, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberships.(*[]GraphMembership), nil
+}
+
+// UpdateTeamMemberships adds and removes members of a team by subject descriptor, then waits for the memberships to
+// be visible.
+func (c *Client) UpdateTeamMemberships(ctx context.Context, teamId string, membersToAdd []string, membersToRemove []string, timeout time.Duration) (*[]GraphMembership, error) {
+	teamDescriptor, err := c.GetTeamDescriptor(ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range membersToRemove {
+		err := c.deleteGroupMembership(ctx, m, *teamDescriptor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range membersToAdd {
+		_, err := c.createGroupMembership(ctx, m, *teamDescriptor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stateConf := c.membershipsStateChangeConf(ctx, *teamDescriptor, teamId, &membersToAdd, &membersToRemove, timeout)
 	memberships, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return nil, err
@@ -308,86 +493,107 @@ func (c *Client) UpdateGroupMemberships(ctx context.Context, projectId string, g
 // Private Methods
 
 func (c *Client) createGroupMembership(ctx context.Context, memberDescriptor string, containerDescriptor string) (*GraphMembership, error) {
+	if err := c.requireGraph("Adding a member to a group"); err != nil {
+		return nil, err
+	}
+
 	pathSegments := []string{pathApis, pathGraph, pathMemberships, memberDescriptor, containerDescriptor}
 	membership, _, err := networking.PutJSON[GraphMembership](c.vsspsClient, ctx, pathSegments, nil, nil, networking.ApiVersion70Preview1)
 	return membership, err
 }
 
 func (c *Client) deleteGroupMembership(ctx context.Context, memberDescriptor string, containerDescriptor string) error {
+	if err := c.requireGraph("Removing a member from a group"); err != nil {
+		return err
+	}
+
 	pathSegments := []string{pathApis, pathGraph, pathMemberships, memberDescriptor, containerDescriptor}
 	_, _, err := networking.DeleteJSON[networking.NoJSON](c.vsspsClient, ctx, pathSegments, nil, networking.ApiVersion70Preview1)
 	return err
 }
 
-func (c *Client) getGroupDescriptor(ctx context.Context, projectId string, name string) (*string, error) {
-	cacheKey := utils.GetCacheKey("Group", projectId, name)
-	if p, ok := c.cache.Get(cacheKey); ok {
-		return p.(*string), nil
+// getGroupMembershipsFromIdentities reads the direct members of a group with the Identities APIs, for Azure DevOps
+// Server collections which don't expose the Graph APIs.
+func (c *Client) getGroupMembershipsFromIdentities(ctx context.Context, groupDescriptor string) (*[]GraphMembership, error) {
+	pathSegments := []string{pathApis, pathIdentities}
+	queryParams := url.Values{"subjectDescriptors": []string{groupDescriptor}, "queryMembership": []string{"direct"}}
+	groups, _, err := networking.GetJSON[IdentityCollection](c.identityClient, ctx, pathSegments, queryParams, networking.ApiVersion70)
+	if err != nil {
+		return nil, err
 	}
 
-	groups, err := c.GetGroups(ctx, projectId, "")
+	memberships := []GraphMembership{}
+	if len(*groups.Value) == 0 || (*groups.Value)[0].Members == nil || len(*(*groups.Value)[0].Members) == 0 {
+		return &memberships, nil
+	}
+
+	queryParams = url.Values{"descriptors": []string{strings.Join(*(*groups.Value)[0].Members, ",")}, "queryMembership": []string{"none"}}
+	members, _, err := networking.GetJSON[IdentityCollection](c.identityClient, ctx, pathSegments, queryParams, networking.ApiVersion70)
 	if err != nil {
 		return nil, err
 	}
 
-	var descriptor *string
-	for _, group := range *groups {
-		if strings.EqualFold(*group.DisplayName, name) || strings.EqualFold(*group.PrincipalName, name) {
-			descriptor = group.Descriptor
-			break
+	for _, member := range *members.Value {
+		if member.SubjectDescriptor == nil {
+			continue
 		}
+		memberships = append(memberships, GraphMembership{
+			ContainerDescriptor: &groupDescriptor,
+			MemberDescriptor:    member.SubjectDescriptor,
+		})
 	}
+	return &memberships, nil
+}
 
-	if descriptor == nil {
-		return nil, errors.New(fmt.Sprintf("Group with name '%s' in project '%s' not found", name, projectId))
+// getGroupsFromIdentities lists the groups of a project with the Identities APIs, for Azure DevOps Server
+// collections which don't expose the Graph APIs.
+func (c *Client) getGroupsFromIdentities(ctx context.Context, projectId string) (*[]GraphGroup, error) {
+	pathSegments := []string{pathApis, pathIdentities, pathGroups}
+	queryParams := url.Values{"scopeIds": []string{projectId}}
+	identities, _, err := networking.GetJSON[IdentityCollection](c.identityClient, ctx, pathSegments, queryParams, networking.ApiVersion70)
+	if err != nil {
+		return nil, err
 	}
 
-	c.cache.Set(cacheKey, descriptor, cache.NoExpiration)
-	return descriptor, nil
-}
-
-func (c *Client) getMemberDescriptors(ctx context.Context, members []string) (*[]string, error) {
-	var memberDescriptors []string
-	for _, member := range members {
-		identity, err := c.GetIdentityPickerIdentity(ctx, member)
-		if err != nil {
-			return nil, err
+	var groups []GraphGroup
+	for _, identity := range *identities.Value {
+		if identity.SubjectDescriptor == nil {
+			continue
 		}
 
-		memberDescriptor := identity.SubjectDescriptor
-		if memberDescriptor == nil {
-			switch strings.ToLower(*identity.EntityType) {
-			case "group":
-				group, err := c.CreateGroupByOriginId(ctx, *identity.OriginId)
-				if err != nil {
-					return nil, err
+		displayName := identity.ProviderDisplayName
+		if properties, ok := identity.Properties.(map[string]interface{}); ok {
+			if account, ok := properties["Account"].(map[string]interface{}); ok {
+				if value, ok := account["$value"].(string); ok {
+					displayName = &value
 				}
-
-				if group.Descriptor == nil {
-					return nil, errors.New(fmt.Sprintf("Unable to find identity descriptor for '%s'", member))
-				}
-
-				memberDescriptor = group.Descriptor
-			case "user":
-				user, err := c.CreateUserByOriginId(ctx, *identity.OriginId)
-				if err != nil {
-					return nil, err
-				}
-
-				if user.Descriptor == nil {
-					return nil, errors.New(fmt.Sprintf("Unable to find identity descriptor for '%s'", member))
-				}
-
-				memberDescriptor = user.Descriptor
-			default:
-				return nil, errors.New(fmt.Sprintf("Unknown entity type '%s'", *identity.EntityType))
 			}
 		}
 
-		memberDescriptors = append(memberDescriptors, *memberDescriptor)
+		groups = append(groups, GraphGroup{
+			Descriptor:       identity.SubjectDescriptor,
+			DisplayName:      displayName,
+			LegacyDescriptor: identity.Descriptor,
+			PrincipalName:    identity.ProviderDisplayName,
+			SubjectKind:      utils.String("group"),
+		})
 	}
+	return &groups, nil
+}
 
-	return &memberDescriptors, nil
+func (c *Client) getMemberships(ctx context.Context, groupDescriptor string) (*[]GraphMembership, error) {
+	if !c.graphAvailable {
+		return c.getGroupMembershipsFromIdentities(ctx, groupDescriptor)
+	}
+
+	pathSegments := []string{pathApis, pathGraph, pathMemberships, groupDescriptor}
+	queryParams := url.Values{"direction": []string{"down"}}
+	memberships, _, err := networking.GetJSON[GraphMembershipCollection](c.vsspsClient, ctx, pathSegments, queryParams, networking.ApiVersion70Preview1)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberships.Value, nil
 }
 
 func (c *Client) getMembershipDescriptors(memberships *[]GraphMembership) *[]string {
@@ -398,9 +604,26 @@ func (c *Client) getMembershipDescriptors(memberships *[]GraphMembership) *[]str
 	return &descriptors
 }
 
+// getPaginatorConfig returns the configuration of a list call of the graph scoped to a project.
+func (c *Client) getPaginatorConfig(ctx context.Context, projectId string, path string) (*networking.PaginatorConfig, error) {
+	projectDescriptor, err := c.getProjectDescriptor(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networking.PaginatorConfig{
+		ApiVersion:   networking.ApiVersion70Preview1,
+		PathSegments: []string{pathApis, pathGraph, path},
+		QueryParams:  url.Values{queryScopeDescriptor: []string{*projectDescriptor}},
+	}, nil
+}
+
 func (c *Client) getProjectDescriptor(ctx context.Context, projectId string) (*string, error) {
-	cacheKey := utils.GetCacheKey("Project", projectId)
-	if p, ok := c.cache.Get(cacheKey); ok {
+	if err := c.requireGraph("Resolving the descriptor of a project"); err != nil {
+		return nil, err
+	}
+
+	if p, ok := c.cache.Get(ctx, utils.CacheKindProjectDescriptor, projectId); ok {
 		return p.(*string), nil
 	}
 
@@ -410,27 +633,39 @@ func (c *Client) getProjectDescriptor(ctx context.Context, projectId string) (*s
 		return nil, err
 	}
 
-	c.cache.Set(cacheKey, result.Value, cache.NoExpiration)
+	c.cache.Set(utils.CacheKindProjectDescriptor, result.Value, projectId)
 	return result.Value, nil
 }
 
-func (c *Client) membershipsStateChangeConf(ctx context.Context, projectId string, groupName string, members *[]string) *utils.StateChangeConf {
+// invalidateGroups removes the cached descriptors and identities of all groups after a group was renamed or deleted,
+// since they are cached under the names of the groups or hold them.
+func (c *Client) invalidateGroups(ctx context.Context) {
+	c.cache.Invalidate(ctx, utils.CacheKindGroupDescriptor, utils.CacheKindIdentity, utils.CacheKindIdentityPicker)
+}
+
+// membershipsStateChangeConf waits for the memberships of a group to contain the added members and none of the
+// removed members, other members being ignored.
+func (c *Client) membershipsStateChangeConf(ctx context.Context, groupDescriptor string, groupName string, membersToAdd *[]string, membersToRemove *[]string, timeout time.Duration) *utils.StateChangeConf {
 	return &utils.StateChangeConf{
 		Delay:      2 * time.Second,
 		MinTimeout: 5 * time.Second,
-		Timeout:    2 * time.Minute,
+		Timeout:    timeout,
 		Pending:    []string{"Waiting"},
 		Target:     []string{"Synced"},
 		Refresh: func() (interface{}, string, error) {
 			state := "Waiting"
-			memberships, err := c.GetGroupMemberships(ctx, projectId, groupName)
+			memberships, err := c.getMemberships(ctx, groupDescriptor)
+			if networking.IsRetryable(err) {
+				logger.Warn(ctx, "Unable to retrieve the memberships of group '"+groupName+"': "+err.Error())
+				return &[]GraphMembership{}, state, nil
+			}
 			if err != nil {
 				return nil, "", err
 			}
 
 			descriptors := c.getMembershipDescriptors(memberships)
-			toAdd := utils.Difference(descriptors, members)
-			toDelete := utils.Difference(members, descriptors)
+			toAdd := utils.Difference(membersToAdd, descriptors)
+			toDelete := utils.Difference(membersToRemove, utils.Difference(membersToRemove, descriptors))
 
 			if len(*toAdd) == 0 && len(*toDelete) == 0 {
 				state = "Synced"
@@ -440,3 +675,12 @@ func (c *Client) membershipsStateChangeConf(ctx context.Context, projectId strin
 		},
 	}
 }
+
+// requireGraph returns ErrGraphNotSupported when the Graph APIs are not available, for the operations which can't fall
+// back to the Identities APIs.
+func (c *Client) requireGraph(operation string) error {
+	if c.graphAvailable {
+		return nil
+	}
+	return fmt.Errorf("%s is not supported on this server: %w", operation, ErrGraphNotSupported)
+}
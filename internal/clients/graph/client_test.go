@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClient_GraphNotSupported(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient(nil, nil, false, nil)
+
+	calls := map[string]func() error{
+		"CreateGroup": func() error {
+			_, err := c.CreateGroup(ctx, "project", "name", "description")
+			return err
+		},
+		"DeleteGroup": func() error {
+			return c.DeleteGroup(ctx, "vssgp.group")
+		},
+		"GetGroup": func() error {
+			_, err := c.GetGroup(ctx, "vssgp.group")
+			return err
+		},
+		"GetTeamMemberships": func() error {
+			_, err := c.GetTeamMemberships(ctx, "team")
+			return err
+		},
+		"GetUsers": func() error {
+			_, err := c.GetUsers(ctx, "project")
+			return err
+		},
+		"UpdateGroup": func() error {
+			_, err := c.UpdateGroup(ctx, "vssgp.group", "name", "description")
+			return err
+		},
+		"UpdateTeamMemberships": func() error {
+			_, err := c.UpdateTeamMemberships(ctx, "team", []string{"aad.user"}, nil, time.Minute)
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, ErrGraphNotSupported) {
+			t.Errorf("%s: expected ErrGraphNotSupported, got %v", name, err)
+		}
+	}
+}
@@ -1,6 +1,12 @@
 package graph
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+)
+
+// GroupDomainProjectPrefix prefixes the domain of the groups scoped to a project, followed by the project ID.
+const GroupDomainProjectPrefix = "vstfs:///Classification/TeamProject/"
 
 type GraphDescriptorResult struct {
 	Links interface{} `json:"_links,omitempty"`
@@ -81,6 +87,11 @@ type GraphUserOriginIdCreationContext struct {
 	StorageKey *uuid.UUID `json:"storageKey,omitempty"`
 }
 
+type IdentityCollection struct {
+	Count *int             `json:"count"`
+	Value *[]core.Identity `json:"value"`
+}
+
 type IdentityPickerIdentity struct {
 	Active                     *bool   `json:"active,omitempty"`
 	Department                 *string `json:"department,omitempty"`
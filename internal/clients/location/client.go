@@ -0,0 +1,38 @@
+package location
+
+import (
+	"context"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"net/url"
+)
+
+const (
+	pathApis           = "_apis"
+	pathConnectionData = "connectionData"
+	pathResourceAreas  = "resourceAreas"
+)
+
+type Client struct {
+	restClient *networking.RestClient
+}
+
+func NewClient(restClient *networking.RestClient) *Client {
+	return &Client{
+		restClient: restClient,
+	}
+}
+
+// GetConnectionData returns the identity authenticated by the credentials of the client, which is anonymous when
+// the organization allows anonymous access and the credentials are not accepted.
+func (c *Client) GetConnectionData(ctx context.Context) (*ConnectionData, error) {
+	pathSegments := []string{pathApis, pathConnectionData}
+	queryParams := url.Values{"connectOptions": []string{"none"}, "lastChangeId": []string{"-1"}, "lastChangeId64": []string{"-1"}}
+	connectionData, _, err := networking.GetJSON[ConnectionData](c.restClient, ctx, pathSegments, queryParams, networking.ApiVersion70Preview1)
+	return connectionData, err
+}
+
+func (c *Client) GetResourceArea(ctx context.Context, areaId string) (*ResourceAreaInfo, error) {
+	pathSegments := []string{pathApis, pathResourceAreas, areaId}
+	resourceArea, _, err := networking.GetJSON[ResourceAreaInfo](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70Preview1)
+	return resourceArea, err
+}
@@ -0,0 +1,24 @@
+package location
+
+import (
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+)
+
+const (
+	ResourceAreaIdGraph = "bb1e7ec9-e901-4b68-999a-de7012b920f8"
+)
+
+type ConnectionData struct {
+	AuthenticatedUser *core.Identity `json:"authenticatedUser,omitempty"`
+	AuthorizedUser    *core.Identity `json:"authorizedUser,omitempty"`
+	DeploymentId      *uuid.UUID     `json:"deploymentId,omitempty"`
+	DeploymentType    *string        `json:"deploymentType,omitempty"`
+	InstanceId        *uuid.UUID     `json:"instanceId,omitempty"`
+}
+
+type ResourceAreaInfo struct {
+	Id          *uuid.UUID `json:"id,omitempty"`
+	LocationUrl *string    `json:"locationUrl,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+}
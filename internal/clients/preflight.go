@@ -0,0 +1,69 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/location"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"net/url"
+)
+
+// MissingScope is an area of the REST APIs the credentials are not allowed to call, e.g. because the personal access
+// token was created without the scope of the area.
+type MissingScope struct {
+	Area  string // Name of the area, as displayed when creating a personal access token
+	Err   error
+	Scope string
+}
+
+type projectReference struct {
+	Id *uuid.UUID `json:"id,omitempty"`
+}
+
+type projectReferenceCollection struct {
+	Value *[]projectReference `json:"value"`
+}
+
+// CheckCredentials returns the identity authenticated by the credentials of the client.
+func (c *AzureDevOpsClient) CheckCredentials(ctx context.Context) (*location.ConnectionData, error) {
+	return c.LocationClient.GetConnectionData(ctx)
+}
+
+// CheckScopes returns the areas of the REST APIs used by the resources of the provider the credentials of the
+// authenticated identity are not allowed to call. Each area is probed with a cheap read, whose failures other than the
+// authorization ones are ignored.
+func (c *AzureDevOpsClient) CheckScopes(ctx context.Context, connectionData *location.ConnectionData) []MissingScope {
+	var missingScopes []MissingScope
+	check := func(area string, scope string, err error) {
+		if errors.Is(err, networking.ErrUnauthorized) || errors.Is(err, networking.ErrForbidden) {
+			missingScopes = append(missingScopes, MissingScope{Area: area, Err: err, Scope: scope})
+		} else if err != nil {
+			logger.Warn(ctx, "Unable to check the scope '"+scope+"' of the credentials: "+err.Error())
+		}
+	}
+
+	pathSegments := []string{"_apis", "projects"}
+	projects, _, err := networking.GetJSON[projectReferenceCollection](c.azdoClient, ctx, pathSegments, url.Values{"$top": []string{"1"}}, networking.ApiVersion70)
+	check("Project & Team", "vso.project", err)
+
+	pathSegments = []string{"_apis", "securitynamespaces"}
+	_, _, err = networking.GetJSON[networking.NoJSON](c.azdoClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	check("Security", "vso.security_manage", err)
+
+	if c.graphAvailable && connectionData.AuthenticatedUser != nil && connectionData.AuthenticatedUser.Id != nil {
+		pathSegments = []string{"_apis", "graph", "descriptors", connectionData.AuthenticatedUser.Id.String()}
+		_, _, err = networking.GetJSON[networking.NoJSON](c.graphClient, ctx, pathSegments, nil, networking.ApiVersion70Preview1)
+		check("Graph", "vso.graph", err)
+	}
+
+	// Service connections are scoped to projects, they can only be probed when a project is visible
+	if projects != nil && projects.Value != nil && len(*projects.Value) > 0 && (*projects.Value)[0].Id != nil {
+		pathSegments = []string{(*projects.Value)[0].Id.String(), "_apis", "serviceendpoint", "endpoints"}
+		_, _, err = networking.GetJSON[networking.NoJSON](c.azdoClient, ctx, pathSegments, url.Values{"$top": []string{"1"}}, networking.ApiVersion70)
+		check("Service Connections", "vso.serviceendpoint", err)
+	}
+
+	return missingScopes
+}
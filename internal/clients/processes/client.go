@@ -0,0 +1,277 @@
+package processes
+
+import (
+	"context"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+)
+
+const (
+	pathApis          = "_apis"
+	pathControls      = "controls"
+	pathFields        = "fields"
+	pathGroups        = "groups"
+	pathLayout        = "layout"
+	pathLists         = "lists"
+	pathPages         = "pages"
+	pathProcesses     = "processes"
+	pathRules         = "rules"
+	pathSections      = "sections"
+	pathStates        = "states"
+	pathWork          = "work"
+	pathWorkItemTypes = "workitemtypes"
+)
+
+type Client struct {
+	restClient *networking.RestClient
+}
+
+func NewClient(restClient *networking.RestClient) *Client {
+	return &Client{
+		restClient: restClient,
+	}
+}
+
+// AddControl adds a control to a group of the layout of a work item type, the ID of a field control being the
+// reference name of its field.
+func (c *Client) AddControl(ctx context.Context, processId string, witRefName string, groupId string, control *Control) (*Control, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathGroups, groupId, pathControls}
+	result, _, err := networking.PostJSON[Control](c.restClient, ctx, pathSegments, nil, control, networking.ApiVersion70)
+	return result, err
+}
+
+func (c *Client) AddGroup(ctx context.Context, processId string, witRefName string, pageId string, sectionId string, group *Group) (*Group, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathPages, pageId, pathSections, sectionId, pathGroups}
+	result, _, err := networking.PostJSON[Group](c.restClient, ctx, pathSegments, nil, group, networking.ApiVersion70)
+	return result, err
+}
+
+func (c *Client) AddPage(ctx context.Context, processId string, witRefName string, page *Page) (*Page, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathPages}
+	result, _, err := networking.PostJSON[Page](c.restClient, ctx, pathSegments, nil, page, networking.ApiVersion70)
+	return result, err
+}
+
+// AddWorkItemTypeField adds a field of the organization to a work item type, or customizes an inherited field.
+func (c *Client) AddWorkItemTypeField(ctx context.Context, processId string, witRefName string, request *ProcessWorkItemTypeFieldRequest) (*ProcessWorkItemTypeField, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathFields}
+	field, _, err := networking.PostJSON[ProcessWorkItemTypeField](c.restClient, ctx, pathSegments, nil, request, networking.ApiVersion70)
+	return field, err
+}
+
+func (c *Client) CreatePicklist(ctx context.Context, picklist *PickList) (*PickList, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, pathLists}
+	result, _, err := networking.PostJSON[PickList](c.restClient, ctx, pathSegments, nil, picklist, networking.ApiVersion70)
+	return result, err
+}
+
+// CreateProcess creates an inherited process from a system process.
+func (c *Client) CreateProcess(ctx context.Context, model *CreateProcessModel) (*ProcessInfo, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses}
+	process, _, err := networking.PostJSON[ProcessInfo](c.restClient, ctx, pathSegments, nil, model, networking.ApiVersion70)
+	return process, err
+}
+
+func (c *Client) CreateRule(ctx context.Context, processId string, witRefName string, rule *ProcessRuleRequest) (*ProcessRule, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathRules}
+	result, _, err := networking.PostJSON[ProcessRule](c.restClient, ctx, pathSegments, nil, rule, networking.ApiVersion70)
+	return result, err
+}
+
+func (c *Client) CreateState(ctx context.Context, processId string, witRefName string, state *WorkItemStateInputModel) (*WorkItemStateResultModel, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathStates}
+	result, _, err := networking.PostJSON[WorkItemStateResultModel](c.restClient, ctx, pathSegments, nil, state, networking.ApiVersion70)
+	return result, err
+}
+
+// CreateWorkItemType creates a custom work item type, or inherits a work item type of the parent process when
+// InheritsFrom is set so that it can be customized.
+func (c *Client) CreateWorkItemType(ctx context.Context, processId string, request *CreateProcessWorkItemTypeRequest) (*ProcessWorkItemType, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes}
+	workItemType, _, err := networking.PostJSON[ProcessWorkItemType](c.restClient, ctx, pathSegments, nil, request, networking.ApiVersion70)
+	return workItemType, err
+}
+
+func (c *Client) DeletePicklist(ctx context.Context, picklistId string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, pathLists, picklistId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+func (c *Client) DeleteProcess(ctx context.Context, processId string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+func (c *Client) DeleteRule(ctx context.Context, processId string, witRefName string, ruleId string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathRules, ruleId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+func (c *Client) DeleteState(ctx context.Context, processId string, witRefName string, stateId string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathStates, stateId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+// DeleteWorkItemType deletes a custom work item type, or removes the customizations of an inherited one.
+func (c *Client) DeleteWorkItemType(ctx context.Context, processId string, witRefName string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+// GetLayout returns the form of a work item type, with its pages, sections, groups and controls.
+func (c *Client) GetLayout(ctx context.Context, processId string, witRefName string) (*FormLayout, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout}
+	layout, _, err := networking.GetJSON[FormLayout](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return layout, err
+}
+
+func (c *Client) GetPicklist(ctx context.Context, picklistId string) (*PickList, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, pathLists, picklistId}
+	picklist, _, err := networking.GetJSON[PickList](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return picklist, err
+}
+
+func (c *Client) GetProcess(ctx context.Context, processId string) (*ProcessInfo, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId}
+	process, _, err := networking.GetJSON[ProcessInfo](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return process, err
+}
+
+// GetProcesses returns the system and inherited processes of the organization.
+func (c *Client) GetProcesses(ctx context.Context) (*[]ProcessInfo, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses}
+	processes, _, err := networking.GetJSON[ProcessInfoCollection](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	if err != nil {
+		return nil, err
+	}
+	return processes.Value, nil
+}
+
+func (c *Client) GetRule(ctx context.Context, processId string, witRefName string, ruleId string) (*ProcessRule, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathRules, ruleId}
+	rule, _, err := networking.GetJSON[ProcessRule](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return rule, err
+}
+
+func (c *Client) GetState(ctx context.Context, processId string, witRefName string, stateId string) (*WorkItemStateResultModel, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathStates, stateId}
+	state, _, err := networking.GetJSON[WorkItemStateResultModel](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return state, err
+}
+
+func (c *Client) GetStates(ctx context.Context, processId string, witRefName string) (*[]WorkItemStateResultModel, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathStates}
+	states, _, err := networking.GetJSON[WorkItemStateResultModelCollection](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	if err != nil {
+		return nil, err
+	}
+	return states.Value, nil
+}
+
+func (c *Client) GetWorkItemType(ctx context.Context, processId string, witRefName string) (*ProcessWorkItemType, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName}
+	workItemType, _, err := networking.GetJSON[ProcessWorkItemType](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return workItemType, err
+}
+
+func (c *Client) GetWorkItemTypeField(ctx context.Context, processId string, witRefName string, fieldRefName string) (*ProcessWorkItemTypeField, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathFields, fieldRefName}
+	field, _, err := networking.GetJSON[ProcessWorkItemTypeField](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return field, err
+}
+
+// GetWorkItemTypes returns the work item types of a process, the ones inherited from the parent process included.
+func (c *Client) GetWorkItemTypes(ctx context.Context, processId string) (*[]ProcessWorkItemType, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes}
+	workItemTypes, _, err := networking.GetJSON[ProcessWorkItemTypeCollection](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	if err != nil {
+		return nil, err
+	}
+	return workItemTypes.Value, nil
+}
+
+func (c *Client) RemoveControl(ctx context.Context, processId string, witRefName string, groupId string, controlId string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathGroups, groupId, pathControls, controlId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+func (c *Client) RemoveGroup(ctx context.Context, processId string, witRefName string, pageId string, sectionId string, groupId string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathPages, pageId, pathSections, sectionId, pathGroups, groupId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+func (c *Client) RemovePage(ctx context.Context, processId string, witRefName string, pageId string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathPages, pageId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+// RemoveWorkItemTypeField removes a field from a work item type, or the customizations of an inherited field.
+func (c *Client) RemoveWorkItemTypeField(ctx context.Context, processId string, witRefName string, fieldRefName string) error {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathFields, fieldRefName}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+func (c *Client) UpdateControl(ctx context.Context, processId string, witRefName string, groupId string, controlId string, control *Control) (*Control, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathGroups, groupId, pathControls, controlId}
+	result, _, err := networking.PatchJSON[Control](c.restClient, ctx, pathSegments, nil, control, networking.ApiVersion70)
+	return result, err
+}
+
+func (c *Client) UpdateGroup(ctx context.Context, processId string, witRefName string, pageId string, sectionId string, groupId string, group *Group) (*Group, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathPages, pageId, pathSections, sectionId, pathGroups, groupId}
+	result, _, err := networking.PatchJSON[Group](c.restClient, ctx, pathSegments, nil, group, networking.ApiVersion70)
+	return result, err
+}
+
+// UpdatePage updates a page of the layout of a work item type, the page being given by the ID of the body.
+func (c *Client) UpdatePage(ctx context.Context, processId string, witRefName string, page *Page) (*Page, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathLayout, pathPages}
+	result, _, err := networking.PatchJSON[Page](c.restClient, ctx, pathSegments, nil, page, networking.ApiVersion70)
+	return result, err
+}
+
+// UpdatePicklist replaces the name, the items and the suggestion mode of a picklist.
+func (c *Client) UpdatePicklist(ctx context.Context, picklistId string, picklist *PickList) (*PickList, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, pathLists, picklistId}
+	result, _, err := networking.PutJSON[PickList](c.restClient, ctx, pathSegments, nil, picklist, networking.ApiVersion70)
+	return result, err
+}
+
+func (c *Client) UpdateProcess(ctx context.Context, processId string, model *UpdateProcessModel) (*ProcessInfo, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId}
+	process, _, err := networking.PatchJSON[ProcessInfo](c.restClient, ctx, pathSegments, nil, model, networking.ApiVersion70)
+	return process, err
+}
+
+// UpdateRule replaces the conditions and the actions of a rule.
+func (c *Client) UpdateRule(ctx context.Context, processId string, witRefName string, ruleId string, rule *ProcessRuleRequest) (*ProcessRule, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathRules, ruleId}
+	result, _, err := networking.PutJSON[ProcessRule](c.restClient, ctx, pathSegments, nil, rule, networking.ApiVersion70)
+	return result, err
+}
+
+func (c *Client) UpdateState(ctx context.Context, processId string, witRefName string, stateId string, state *WorkItemStateInputModel) (*WorkItemStateResultModel, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathStates, stateId}
+	result, _, err := networking.PatchJSON[WorkItemStateResultModel](c.restClient, ctx, pathSegments, nil, state, networking.ApiVersion70)
+	return result, err
+}
+
+func (c *Client) UpdateWorkItemType(ctx context.Context, processId string, witRefName string, request *UpdateProcessWorkItemTypeRequest) (*ProcessWorkItemType, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName}
+	workItemType, _, err := networking.PatchJSON[ProcessWorkItemType](c.restClient, ctx, pathSegments, nil, request, networking.ApiVersion70)
+	return workItemType, err
+}
+
+func (c *Client) UpdateWorkItemTypeField(ctx context.Context, processId string, witRefName string, fieldRefName string, request *ProcessWorkItemTypeFieldRequest) (*ProcessWorkItemTypeField, error) {
+	pathSegments := []string{pathApis, pathWork, pathProcesses, processId, pathWorkItemTypes, witRefName, pathFields, fieldRefName}
+	field, _, err := networking.PatchJSON[ProcessWorkItemTypeField](c.restClient, ctx, pathSegments, nil, request, networking.ApiVersion70)
+	return field, err
+}
@@ -0,0 +1,236 @@
+package processes
+
+import "github.com/google/uuid"
+
+const (
+	CustomizationTypeCustom    = "custom"
+	CustomizationTypeInherited = "inherited"
+	CustomizationTypeSystem    = "system"
+
+	PageTypeCustom = "custom"
+
+	PicklistTypeDouble  = "Double"
+	PicklistTypeInteger = "Integer"
+	PicklistTypeString  = "String"
+
+	StateCategoryCompleted  = "Completed"
+	StateCategoryInProgress = "InProgress"
+	StateCategoryProposed   = "Proposed"
+	StateCategoryRemoved    = "Removed"
+	StateCategoryResolved   = "Resolved"
+)
+
+type Control struct {
+	Contribution   interface{} `json:"contribution,omitempty"`
+	ControlType    *string     `json:"controlType,omitempty"`
+	Height         *int        `json:"height,omitempty"`
+	Id             *string     `json:"id,omitempty"`
+	Inherited      *bool       `json:"inherited,omitempty"`
+	IsContribution *bool       `json:"isContribution,omitempty"`
+	Label          *string     `json:"label,omitempty"`
+	Metadata       *string     `json:"metadata,omitempty"`
+	Order          *int        `json:"order,omitempty"`
+	Overridden     *bool       `json:"overridden,omitempty"`
+	ReadOnly       *bool       `json:"readOnly,omitempty"`
+	Visible        *bool       `json:"visible,omitempty"`
+	Watermark      *string     `json:"watermark,omitempty"`
+}
+
+type CreateProcessModel struct {
+	Description         *string    `json:"description,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	ParentProcessTypeId *uuid.UUID `json:"parentProcessTypeId,omitempty"`
+	ReferenceName       *string    `json:"referenceName,omitempty"`
+}
+
+type CreateProcessWorkItemTypeRequest struct {
+	Color        *string `json:"color,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Icon         *string `json:"icon,omitempty"`
+	InheritsFrom *string `json:"inheritsFrom,omitempty"`
+	IsDisabled   *bool   `json:"isDisabled,omitempty"`
+	Name         *string `json:"name,omitempty"`
+}
+
+type FormLayout struct {
+	Extensions     interface{} `json:"extensions,omitempty"`
+	Pages          *[]Page     `json:"pages,omitempty"`
+	SystemControls *[]Control  `json:"systemControls,omitempty"`
+}
+
+type Group struct {
+	Contribution   interface{} `json:"contribution,omitempty"`
+	Controls       *[]Control  `json:"controls,omitempty"`
+	Height         *int        `json:"height,omitempty"`
+	Id             *string     `json:"id,omitempty"`
+	Inherited      *bool       `json:"inherited,omitempty"`
+	IsContribution *bool       `json:"isContribution,omitempty"`
+	Label          *string     `json:"label,omitempty"`
+	Order          *int        `json:"order,omitempty"`
+	Overridden     *bool       `json:"overridden,omitempty"`
+	Visible        *bool       `json:"visible,omitempty"`
+}
+
+type Page struct {
+	Contribution   interface{} `json:"contribution,omitempty"`
+	Id             *string     `json:"id,omitempty"`
+	Inherited      *bool       `json:"inherited,omitempty"`
+	IsContribution *bool       `json:"isContribution,omitempty"`
+	Label          *string     `json:"label,omitempty"`
+	Locked         *bool       `json:"locked,omitempty"`
+	Order          *int        `json:"order,omitempty"`
+	Overridden     *bool       `json:"overridden,omitempty"`
+	PageType       *string     `json:"pageType,omitempty"`
+	Sections       *[]Section  `json:"sections,omitempty"`
+	Visible        *bool       `json:"visible,omitempty"`
+}
+
+type PickList struct {
+	Id          *uuid.UUID `json:"id,omitempty"`
+	IsSuggested *bool      `json:"isSuggested,omitempty"`
+	Items       *[]string  `json:"items,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Type        *string    `json:"type,omitempty"`
+	Url         *string    `json:"url,omitempty"`
+}
+
+type PickListCollection struct {
+	Count *int        `json:"count"`
+	Value *[]PickList `json:"value"`
+}
+
+type ProcessInfo struct {
+	CustomizationType   *string    `json:"customizationType,omitempty"`
+	Description         *string    `json:"description,omitempty"`
+	IsDefault           *bool      `json:"isDefault,omitempty"`
+	IsEnabled           *bool      `json:"isEnabled,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	ParentProcessTypeId *uuid.UUID `json:"parentProcessTypeId,omitempty"`
+	ReferenceName       *string    `json:"referenceName,omitempty"`
+	TypeId              *uuid.UUID `json:"typeId,omitempty"`
+}
+
+type ProcessInfoCollection struct {
+	Count *int           `json:"count"`
+	Value *[]ProcessInfo `json:"value"`
+}
+
+type ProcessRule struct {
+	Actions           *[]RuleAction    `json:"actions,omitempty"`
+	Conditions        *[]RuleCondition `json:"conditions,omitempty"`
+	CustomizationType *string          `json:"customizationType,omitempty"`
+	Id                *uuid.UUID       `json:"id,omitempty"`
+	IsDisabled        *bool            `json:"isDisabled,omitempty"`
+	IsSystem          *bool            `json:"isSystem,omitempty"`
+	Name              *string          `json:"name,omitempty"`
+	Url               *string          `json:"url,omitempty"`
+}
+
+type ProcessRuleCollection struct {
+	Count *int           `json:"count"`
+	Value *[]ProcessRule `json:"value"`
+}
+
+type ProcessRuleRequest struct {
+	Actions    *[]RuleAction    `json:"actions,omitempty"`
+	Conditions *[]RuleCondition `json:"conditions,omitempty"`
+	Id         *uuid.UUID       `json:"id,omitempty"`
+	IsDisabled *bool            `json:"isDisabled,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+}
+
+type ProcessWorkItemType struct {
+	Class         *string                     `json:"class,omitempty"`
+	Color         *string                     `json:"color,omitempty"`
+	Customization *string                     `json:"customization,omitempty"`
+	Description   *string                     `json:"description,omitempty"`
+	Icon          *string                     `json:"icon,omitempty"`
+	Inherits      *string                     `json:"inherits,omitempty"`
+	IsDisabled    *bool                       `json:"isDisabled,omitempty"`
+	Layout        *FormLayout                 `json:"layout,omitempty"`
+	Name          *string                     `json:"name,omitempty"`
+	ReferenceName *string                     `json:"referenceName,omitempty"`
+	States        *[]WorkItemStateResultModel `json:"states,omitempty"`
+	Url           *string                     `json:"url,omitempty"`
+}
+
+type ProcessWorkItemTypeCollection struct {
+	Count *int                   `json:"count"`
+	Value *[]ProcessWorkItemType `json:"value"`
+}
+
+type ProcessWorkItemTypeField struct {
+	AllowGroups   *bool       `json:"allowGroups,omitempty"`
+	Customization *string     `json:"customization,omitempty"`
+	DefaultValue  interface{} `json:"defaultValue,omitempty"`
+	Description   *string     `json:"description,omitempty"`
+	Name          *string     `json:"name,omitempty"`
+	ReadOnly      *bool       `json:"readOnly,omitempty"`
+	ReferenceName *string     `json:"referenceName,omitempty"`
+	Required      *bool       `json:"required,omitempty"`
+	Type          *string     `json:"type,omitempty"`
+	Url           *string     `json:"url,omitempty"`
+}
+
+type ProcessWorkItemTypeFieldRequest struct {
+	AllowGroups   *bool       `json:"allowGroups,omitempty"`
+	DefaultValue  interface{} `json:"defaultValue"`
+	ReadOnly      *bool       `json:"readOnly,omitempty"`
+	ReferenceName *string     `json:"referenceName,omitempty"`
+	Required      *bool       `json:"required,omitempty"`
+}
+
+type RuleAction struct {
+	ActionType  *string `json:"actionType,omitempty"`
+	TargetField *string `json:"targetField,omitempty"`
+	Value       *string `json:"value,omitempty"`
+}
+
+type RuleCondition struct {
+	ConditionType *string `json:"conditionType,omitempty"`
+	Field         *string `json:"field,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
+
+type Section struct {
+	Groups     *[]Group `json:"groups,omitempty"`
+	Id         *string  `json:"id,omitempty"`
+	Overridden *bool    `json:"overridden,omitempty"`
+}
+
+type UpdateProcessModel struct {
+	Description *string `json:"description,omitempty"`
+	IsDefault   *bool   `json:"isDefault,omitempty"`
+	IsEnabled   *bool   `json:"isEnabled,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+type UpdateProcessWorkItemTypeRequest struct {
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	IsDisabled  *bool   `json:"isDisabled,omitempty"`
+}
+
+type WorkItemStateInputModel struct {
+	Color         *string `json:"color,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Order         *int    `json:"order,omitempty"`
+	StateCategory *string `json:"stateCategory,omitempty"`
+}
+
+type WorkItemStateResultModel struct {
+	Color             *string    `json:"color,omitempty"`
+	CustomizationType *string    `json:"customizationType,omitempty"`
+	Hidden            *bool      `json:"hidden,omitempty"`
+	Id                *uuid.UUID `json:"id,omitempty"`
+	Name              *string    `json:"name,omitempty"`
+	Order             *int       `json:"order,omitempty"`
+	StateCategory     *string    `json:"stateCategory,omitempty"`
+	Url               *string    `json:"url,omitempty"`
+}
+
+type WorkItemStateResultModelCollection struct {
+	Count *int                        `json:"count"`
+	Value *[]WorkItemStateResultModel `json:"value"`
+}
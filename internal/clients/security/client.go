@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/patrickmn/go-cache"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"golang.org/x/exp/slices"
 	"net/url"
 	"strconv"
 	"strings"
@@ -18,18 +18,21 @@ const (
 	pathApis                 = "_apis"
 	pathIdentities           = "identities"
 	pathSecurityNamespaces   = "securitynamespaces"
+
+	// Descriptors are sent in the query string, the batches are kept small enough for the url length limits
+	maxIdentitiesBatchSize = 50
 )
 
 type Client struct {
 	azdoClient  *networking.RestClient
-	cache       *cache.Cache
+	cache       *utils.Cache
 	vsspsClient *networking.RestClient
 }
 
-func NewClient(restClient *networking.RestClient, vsspsClient *networking.RestClient) *Client {
+func NewClient(restClient *networking.RestClient, vsspsClient *networking.RestClient, cache *utils.Cache) *Client {
 	return &Client{
 		azdoClient:  restClient,
-		cache:       cache.New(cache.NoExpiration, 0),
+		cache:       cache,
 		vsspsClient: vsspsClient,
 	}
 }
@@ -53,6 +56,23 @@ func (c *Client) GetEnvironmentToken(projectId string, environmentId int) string
 	return token
 }
 
+// GetIdentitiesByDescriptors returns the identities of descriptors keyed by descriptor, resolving the descriptors
+// which are not cached yet in batches rather than one request per descriptor. The descriptors which do not resolve to
+// an identity are missing from the result.
+func (c *Client) GetIdentitiesByDescriptors(ctx context.Context, descriptors []string) (map[string]*Identity, error) {
+	return c.getIdentities(ctx, "descriptors", descriptors, func(identity *Identity) *string {
+		return identity.Descriptor
+	})
+}
+
+// GetIdentitiesBySubjectDescriptors returns the identities of subject descriptors keyed by subject descriptor, see
+// GetIdentitiesByDescriptors.
+func (c *Client) GetIdentitiesBySubjectDescriptors(ctx context.Context, subjectDescriptors []string) (map[string]*Identity, error) {
+	return c.getIdentities(ctx, "subjectDescriptors", subjectDescriptors, func(identity *Identity) *string {
+		return identity.SubjectDescriptor
+	})
+}
+
 func (c *Client) GetIdentityByDescriptor(ctx context.Context, descriptor string) (*Identity, error) {
 	queryParams := url.Values{"descriptors": []string{descriptor}, "queryMembership": []string{"none"}}
 	return c.getIdentity(ctx, queryParams, descriptor)
@@ -84,8 +104,7 @@ func (c *Client) GetRepositoryToken(projectId string, repositoryId string) strin
 }
 
 func (c *Client) GetSecurityNamespaces(ctx context.Context) (*SecurityNamespacesCollection, error) {
-	cacheKey := utils.GetCacheKey("Namespaces")
-	if n, ok := c.cache.Get(cacheKey); ok {
+	if n, ok := c.cache.Get(ctx, utils.CacheKindSecurityNamespaces); ok {
 		return n.(*SecurityNamespacesCollection), nil
 	}
 
@@ -95,7 +114,7 @@ func (c *Client) GetSecurityNamespaces(ctx context.Context) (*SecurityNamespaces
 		return nil, err
 	}
 
-	c.cache.Set(cacheKey, namespaces, cache.NoExpiration)
+	c.cache.Set(utils.CacheKindSecurityNamespaces, namespaces)
 	return namespaces, err
 }
 
@@ -107,6 +126,12 @@ func (c *Client) GetServiceEndpointToken(projectId string, serviceEndpointId str
 	return token
 }
 
+// GetTeamToken returns the token of a team in the Identity namespace, on which the administrators of the team are
+// granted their permissions.
+func (c *Client) GetTeamToken(projectId string, teamId string) string {
+	return fmt.Sprintf("%s\\%s", projectId, teamId)
+}
+
 func (c *Client) RemoveAccessControlEntries(ctx context.Context, namespaceId string, token string, descriptors []string) error {
 	pathSegments := []string{pathApis, pathAccessControlEntries, namespaceId}
 	queryParams := url.Values{"token": []string{token}, "descriptors": []string{strings.Join(descriptors, ",")}}
@@ -143,9 +168,58 @@ func (c *Client) SetAccessControlLists(ctx context.Context, namespaceId string,
 
 // Private Methods
 
+func (c *Client) getIdentities(ctx context.Context, queryKey string, descriptors []string, descriptorOf func(identity *Identity) *string) (map[string]*Identity, error) {
+	identities := map[string]*Identity{}
+	var uncached []string
+	for _, descriptor := range descriptors {
+		if _, ok := identities[descriptor]; ok {
+			continue
+		}
+		if i, ok := c.cache.Get(ctx, utils.CacheKindIdentity, descriptor); ok {
+			identities[descriptor] = i.(*Identity)
+		} else if !slices.Contains(uncached, descriptor) {
+			uncached = append(uncached, descriptor)
+		}
+	}
+
+	pathSegments := []string{pathApis, pathIdentities}
+	for start := 0; start < len(uncached); start += maxIdentitiesBatchSize {
+		end := start + maxIdentitiesBatchSize
+		if end > len(uncached) {
+			end = len(uncached)
+		}
+		batch := uncached[start:end]
+
+		queryParams := url.Values{queryKey: []string{strings.Join(batch, ",")}, "queryMembership": []string{"none"}}
+		identityResult, _, err := networking.GetJSON[IdentityCollection](c.vsspsClient, ctx, pathSegments, queryParams, networking.ApiVersion70)
+		if err != nil {
+			return nil, err
+		}
+		if identityResult.Value == nil {
+			continue
+		}
+
+		// Unresolved descriptors are returned as null, and legacy descriptors may differ in case from the requested ones
+		for i := range *identityResult.Value {
+			identity := &(*identityResult.Value)[i]
+			descriptor := descriptorOf(identity)
+			if descriptor == nil {
+				continue
+			}
+			for _, requested := range batch {
+				if strings.EqualFold(requested, *descriptor) {
+					identities[requested] = identity
+					c.cache.Set(utils.CacheKindIdentity, identity, requested)
+				}
+			}
+		}
+	}
+
+	return identities, nil
+}
+
 func (c *Client) getIdentity(ctx context.Context, queryParams url.Values, descriptor string) (*Identity, error) {
-	cacheKey := utils.GetCacheKey("Identity", descriptor)
-	if i, ok := c.cache.Get(cacheKey); ok {
+	if i, ok := c.cache.Get(ctx, utils.CacheKindIdentity, descriptor); ok {
 		return i.(*Identity), nil
 	}
 
@@ -160,6 +234,6 @@ func (c *Client) getIdentity(ctx context.Context, queryParams url.Values, descri
 	}
 
 	identity := (*identityResult.Value)[0]
-	c.cache.Set(cacheKey, &identity, cache.NoExpiration)
+	c.cache.Set(utils.CacheKindIdentity, &identity, descriptor)
 	return &identity, err
 }
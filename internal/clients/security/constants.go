@@ -62,3 +62,9 @@ const (
 	NamespaceIdWorkItemsHub                   = "c0e7a722-1cad-4ae6-b340-a8467501e7ce"
 	NamespaceIdWorkspaces                     = "93bafc04-9075-403a-9367-b7164eac6b5c"
 )
+
+const (
+	// Permissions of the Identity namespace granted on a team to its administrators: Read, Write, Delete,
+	// ManageMembership and CreateScope
+	IdentityPermissionsTeamAdministrator = 31
+)
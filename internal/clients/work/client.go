@@ -0,0 +1,76 @@
+package work
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+)
+
+const (
+	pathApis            = "_apis"
+	pathIterations      = "iterations"
+	pathTeamFieldValues = "teamfieldvalues"
+	pathTeamSettings    = "teamsettings"
+	pathWork            = "work"
+)
+
+type Client struct {
+	restClient *networking.RestClient
+}
+
+func NewClient(restClient *networking.RestClient) *Client {
+	return &Client{
+		restClient: restClient,
+	}
+}
+
+// AddTeamIteration subscribes a team to an iteration, given by its identifier.
+func (c *Client) AddTeamIteration(ctx context.Context, projectId string, teamId string, iterationId uuid.UUID) (*TeamSettingsIteration, error) {
+	pathSegments := []string{projectId, teamId, pathApis, pathWork, pathTeamSettings, pathIterations}
+	body := TeamSettingsIteration{Id: &iterationId}
+	iteration, _, err := networking.PostJSON[TeamSettingsIteration](c.restClient, ctx, pathSegments, nil, body, networking.ApiVersion70)
+	return iteration, err
+}
+
+// GetTeamFieldValues returns the areas of a team, which are the values of the team field of a project using areas.
+func (c *Client) GetTeamFieldValues(ctx context.Context, projectId string, teamId string) (*TeamFieldValues, error) {
+	pathSegments := []string{projectId, teamId, pathApis, pathWork, pathTeamSettings, pathTeamFieldValues}
+	values, _, err := networking.GetJSON[TeamFieldValues](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return values, err
+}
+
+func (c *Client) GetTeamIterations(ctx context.Context, projectId string, teamId string) (*[]TeamSettingsIteration, error) {
+	pathSegments := []string{projectId, teamId, pathApis, pathWork, pathTeamSettings, pathIterations}
+	iterations, _, err := networking.GetJSON[TeamSettingsIterationCollection](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	if err != nil {
+		return nil, err
+	}
+	return iterations.Value, nil
+}
+
+func (c *Client) GetTeamSettings(ctx context.Context, projectId string, teamId string) (*TeamSetting, error) {
+	pathSegments := []string{projectId, teamId, pathApis, pathWork, pathTeamSettings}
+	settings, _, err := networking.GetJSON[TeamSetting](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return settings, err
+}
+
+// RemoveTeamIteration unsubscribes a team from an iteration, given by its identifier.
+func (c *Client) RemoveTeamIteration(ctx context.Context, projectId string, teamId string, iterationId string) error {
+	pathSegments := []string{projectId, teamId, pathApis, pathWork, pathTeamSettings, pathIterations, iterationId}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
+// UpdateTeamFieldValues replaces the areas of a team and its default area.
+func (c *Client) UpdateTeamFieldValues(ctx context.Context, projectId string, teamId string, patch *TeamFieldValuesPatch) (*TeamFieldValues, error) {
+	pathSegments := []string{projectId, teamId, pathApis, pathWork, pathTeamSettings, pathTeamFieldValues}
+	values, _, err := networking.PatchJSON[TeamFieldValues](c.restClient, ctx, pathSegments, nil, patch, networking.ApiVersion70)
+	return values, err
+}
+
+// UpdateTeamSettings updates the settings of a team, the settings missing from the patch being left unchanged.
+func (c *Client) UpdateTeamSettings(ctx context.Context, projectId string, teamId string, patch *TeamSettingsPatch) (*TeamSetting, error) {
+	pathSegments := []string{projectId, teamId, pathApis, pathWork, pathTeamSettings}
+	settings, _, err := networking.PatchJSON[TeamSetting](c.restClient, ctx, pathSegments, nil, patch, networking.ApiVersion70)
+	return settings, err
+}
@@ -0,0 +1,74 @@
+package work
+
+import "github.com/google/uuid"
+
+const (
+	BugsBehaviorAsRequirements = "asRequirements"
+	BugsBehaviorAsTasks        = "asTasks"
+	BugsBehaviorOff            = "off"
+
+	// The default iteration of the team follows the current iteration
+	DefaultIterationMacroCurrentIteration = "@currentIteration"
+)
+
+type FieldReference struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+	Url           *string `json:"url,omitempty"`
+}
+
+type TeamFieldValue struct {
+	IncludeChildren *bool   `json:"includeChildren,omitempty"`
+	Value           *string `json:"value,omitempty"`
+}
+
+type TeamFieldValues struct {
+	DefaultValue *string           `json:"defaultValue,omitempty"`
+	Field        *FieldReference   `json:"field,omitempty"`
+	Links        interface{}       `json:"_links,omitempty"`
+	Url          *string           `json:"url,omitempty"`
+	Values       *[]TeamFieldValue `json:"values,omitempty"`
+}
+
+type TeamFieldValuesPatch struct {
+	DefaultValue *string           `json:"defaultValue,omitempty"`
+	Values       *[]TeamFieldValue `json:"values,omitempty"`
+}
+
+type TeamIterationAttributes struct {
+	FinishDate *string `json:"finishDate,omitempty"`
+	StartDate  *string `json:"startDate,omitempty"`
+	TimeFrame  *string `json:"timeFrame,omitempty"`
+}
+
+type TeamSetting struct {
+	BacklogIteration      *TeamSettingsIteration `json:"backlogIteration,omitempty"`
+	BacklogVisibilities   *map[string]bool       `json:"backlogVisibilities,omitempty"`
+	BugsBehavior          *string                `json:"bugsBehavior,omitempty"`
+	DefaultIteration      *TeamSettingsIteration `json:"defaultIteration,omitempty"`
+	DefaultIterationMacro *string                `json:"defaultIterationMacro,omitempty"`
+	Links                 interface{}            `json:"_links,omitempty"`
+	Url                   *string                `json:"url,omitempty"`
+	WorkingDays           *[]string              `json:"workingDays,omitempty"`
+}
+
+type TeamSettingsIteration struct {
+	Attributes *TeamIterationAttributes `json:"attributes,omitempty"`
+	Id         *uuid.UUID               `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Path       *string                  `json:"path,omitempty"`
+	Url        *string                  `json:"url,omitempty"`
+}
+
+type TeamSettingsIterationCollection struct {
+	Count *int                     `json:"count"`
+	Value *[]TeamSettingsIteration `json:"value"`
+}
+
+type TeamSettingsPatch struct {
+	BacklogIteration      *uuid.UUID       `json:"backlogIteration,omitempty"`
+	BacklogVisibilities   *map[string]bool `json:"backlogVisibilities,omitempty"`
+	BugsBehavior          *string          `json:"bugsBehavior,omitempty"`
+	DefaultIteration      *uuid.UUID       `json:"defaultIteration,omitempty"`
+	DefaultIterationMacro *string          `json:"defaultIterationMacro,omitempty"`
+	WorkingDays           *[]string        `json:"workingDays,omitempty"`
+}
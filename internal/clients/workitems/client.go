@@ -10,6 +10,7 @@ const (
 	pathClassificationNodes          = "classificationnodes"
 	pathClassificationNodeAreas      = "areas"
 	pathClassificationNodeIterations = "iterations"
+	pathFields                       = "fields"
 	pathWit                          = "wit"
 )
 
@@ -31,6 +32,14 @@ func (c *Client) CreateIteration(ctx context.Context, projectId string, path str
 	return c.createWorkItemClassificationNode(ctx, pathClassificationNodeIterations, projectId, path, name, c.buildIterationAttributes(startDate, finishDate))
 }
 
+// CreateField creates a custom field of the work items of the organization, which can then be added to the work item
+// types of the inherited processes.
+func (c *Client) CreateField(ctx context.Context, field *WorkItemField) (*WorkItemField, error) {
+	pathSegments := []string{pathApis, pathWit, pathFields}
+	result, _, err := networking.PostJSON[WorkItemField](c.restClient, ctx, pathSegments, nil, field, networking.ApiVersion70)
+	return result, err
+}
+
 func (c *Client) DeleteArea(ctx context.Context, projectId string, path string) error {
 	return c.deleteWorkItemClassificationNode(ctx, pathClassificationNodeAreas, projectId, path)
 }
@@ -39,10 +48,22 @@ func (c *Client) DeleteIteration(ctx context.Context, projectId string, path str
 	return c.deleteWorkItemClassificationNode(ctx, pathClassificationNodeIterations, projectId, path)
 }
 
+func (c *Client) DeleteField(ctx context.Context, referenceName string) error {
+	pathSegments := []string{pathApis, pathWit, pathFields, referenceName}
+	_, _, err := networking.DeleteJSON[networking.NoJSON](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return err
+}
+
 func (c *Client) GetArea(ctx context.Context, projectId string, path string) (*WorkItemClassificationNode, error) {
 	return c.getWorkItemClassificationNode(ctx, pathClassificationNodeAreas, projectId, path)
 }
 
+func (c *Client) GetField(ctx context.Context, referenceName string) (*WorkItemField, error) {
+	pathSegments := []string{pathApis, pathWit, pathFields, referenceName}
+	field, _, err := networking.GetJSON[WorkItemField](c.restClient, ctx, pathSegments, nil, networking.ApiVersion70)
+	return field, err
+}
+
 func (c *Client) GetIteration(ctx context.Context, projectId string, path string) (*WorkItemClassificationNode, error) {
 	return c.getWorkItemClassificationNode(ctx, pathClassificationNodeIterations, projectId, path)
 }
@@ -2,6 +2,19 @@ package workitems
 
 import "github.com/google/uuid"
 
+const (
+	FieldTypeBoolean   = "boolean"
+	FieldTypeDateTime  = "dateTime"
+	FieldTypeDouble    = "double"
+	FieldTypeHtml      = "html"
+	FieldTypeIdentity  = "identity"
+	FieldTypeInteger   = "integer"
+	FieldTypePlainText = "plainText"
+	FieldTypeString    = "string"
+
+	FieldUsageWorkItem = "workItem"
+)
+
 type WorkItemClassificationNode struct {
 	Attributes    *map[string]interface{}       `json:"attributes,omitempty"`
 	Children      *[]WorkItemClassificationNode `json:"children,omitempty"`
@@ -14,3 +27,18 @@ type WorkItemClassificationNode struct {
 	StructureType *string                       `json:"structureType,omitempty"`
 	Url           *string                       `json:"url,omitempty"`
 }
+
+type WorkItemField struct {
+	Description         *string    `json:"description,omitempty"`
+	IsDeleted           *bool      `json:"isDeleted,omitempty"`
+	IsIdentity          *bool      `json:"isIdentity,omitempty"`
+	IsPicklist          *bool      `json:"isPicklist,omitempty"`
+	IsPicklistSuggested *bool      `json:"isPicklistSuggested,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	PicklistId          *uuid.UUID `json:"picklistId,omitempty"`
+	ReadOnly            *bool      `json:"readOnly,omitempty"`
+	ReferenceName       *string    `json:"referenceName,omitempty"`
+	Type                *string    `json:"type,omitempty"`
+	Url                 *string    `json:"url,omitempty"`
+	Usage               *string    `json:"usage,omitempty"`
+}
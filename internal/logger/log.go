@@ -2,49 +2,94 @@ package logger
 
 import (
 	"context"
-	"github.com/hashicorp/go-hclog"
-	"os"
-	"sync"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
 )
 
-var lock = &sync.Mutex{}
-var logger *hclog.Logger
+const (
+	SubsystemCore             = "core"
+	SubsystemGraph            = "graph"
+	SubsystemLocation         = "location"
+	SubsystemNetworking       = "networking"
+	SubsystemPipelines        = "pipelines"
+	SubsystemProcesses        = "processes"
+	SubsystemSecurity         = "security"
+	SubsystemServiceEndpoints = "serviceendpoints"
+	SubsystemWork             = "work"
+	SubsystemWorkItems        = "workitems"
 
-func Debug(_ context.Context, message string) {
-	defaultLogger().Debug(message)
+	// The level of a subsystem is read from TF_LOG_PROVIDER_AZUREDEVOPS_<SUBSYSTEM>, e.g.
+	// TF_LOG_PROVIDER_AZUREDEVOPS_GRAPH=TRACE, and inherited from the provider logger when not set.
+	envLogLevel = "TF_LOG_PROVIDER_AZUREDEVOPS"
+)
+
+// Keys of the log fields whose values are never written, matched exactly.
+var sensitiveFieldKeys = []string{
+	"access_token", "authorization", "client_assertion", "client_secret", "kubeconfig", "password",
+	"personal_access_token", "refresh_token", "secret", "token",
 }
 
-func Error(_ context.Context, message string) {
-	defaultLogger().Error(message)
+// Credentials sent in the Authorization header, masked wherever they appear in messages or field values.
+var sensitiveValueRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\b(basic|bearer)\s+[a-z0-9._~+/=-]+`),
 }
 
-func Info(_ context.Context, message string) {
-	defaultLogger().Info(message)
+type subsystemContextKey struct{}
+
+// NewSubsystem returns a context whose messages are logged by the logger of a subsystem, e.g. the client of an area
+// of the REST APIs. The sensitive fields and credentials are masked in everything the subsystem logs.
+func NewSubsystem(ctx context.Context, subsystem string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithAdditionalLocationOffset(1), tflog.WithLevelFromEnv(envLogLevel, subsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, sensitiveFieldKeys...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, subsystem, sensitiveValueRegexps...)
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, subsystem, sensitiveValueRegexps...)
+	return context.WithValue(ctx, subsystemContextKey{}, subsystem)
 }
 
-func Trace(_ context.Context, message string) {
-	defaultLogger().Trace(message)
+// SetField returns a context whose messages all include a field, e.g. the identifier of a request.
+func SetField(ctx context.Context, key string, value any) context.Context {
+	if subsystem, ok := ctx.Value(subsystemContextKey{}).(string); ok {
+		return tflog.SubsystemSetField(ctx, subsystem, key, value)
+	}
+	return tflog.SetField(ctx, key, value)
 }
 
-func Warn(_ context.Context, message string) {
-	defaultLogger().Warn(message)
+func Debug(ctx context.Context, message string, fields ...map[string]any) {
+	if subsystem, ok := ctx.Value(subsystemContextKey{}).(string); ok {
+		tflog.SubsystemDebug(ctx, subsystem, message, fields...)
+		return
+	}
+	tflog.Debug(ctx, message, fields...)
 }
 
-// Private Methods
+func Error(ctx context.Context, message string, fields ...map[string]any) {
+	if subsystem, ok := ctx.Value(subsystemContextKey{}).(string); ok {
+		tflog.SubsystemError(ctx, subsystem, message, fields...)
+		return
+	}
+	tflog.Error(ctx, message, fields...)
+}
+
+func Info(ctx context.Context, message string, fields ...map[string]any) {
+	if subsystem, ok := ctx.Value(subsystemContextKey{}).(string); ok {
+		tflog.SubsystemInfo(ctx, subsystem, message, fields...)
+		return
+	}
+	tflog.Info(ctx, message, fields...)
+}
+
+func Trace(ctx context.Context, message string, fields ...map[string]any) {
+	if subsystem, ok := ctx.Value(subsystemContextKey{}).(string); ok {
+		tflog.SubsystemTrace(ctx, subsystem, message, fields...)
+		return
+	}
+	tflog.Trace(ctx, message, fields...)
+}
 
-func defaultLogger() hclog.Logger {
-	if logger == nil {
-		defer lock.Unlock()
-		lock.Lock()
-		level := os.Getenv("TF_LOG_AZDO")
-		if level == "" {
-			level = "OFF"
-		}
-		instance := hclog.New(&hclog.LoggerOptions{
-			Level:  hclog.LevelFromString(level),
-			Output: os.Stdin,
-		})
-		logger = &instance
+func Warn(ctx context.Context, message string, fields ...map[string]any) {
+	if subsystem, ok := ctx.Value(subsystemContextKey{}).(string); ok {
+		tflog.SubsystemWarn(ctx, subsystem, message, fields...)
+		return
 	}
-	return *logger
+	tflog.Warn(ctx, message, fields...)
 }
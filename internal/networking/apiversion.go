@@ -0,0 +1,249 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Delay before the resource locations are read again after a failed negotiation.
+const apiVersionNegotiationRetryDelay = time.Minute
+
+// Versions of the REST APIs are formatted like '7.1' or '7.1-preview.1'.
+var apiVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)(-preview(\.\d+)?)?$`)
+
+// ApiResourceLocation is a route of the REST APIs of an organization, along with the versions it supports.
+type ApiResourceLocation struct {
+	Area            *string `json:"area,omitempty"`
+	Id              *string `json:"id,omitempty"`
+	MaxVersion      *string `json:"maxVersion,omitempty"`
+	MinVersion      *string `json:"minVersion,omitempty"`
+	ReleasedVersion *string `json:"releasedVersion,omitempty"`
+	ResourceName    *string `json:"resourceName,omitempty"`
+	ResourceVersion *int    `json:"resourceVersion,omitempty"`
+	RouteTemplate   *string `json:"routeTemplate,omitempty"`
+}
+
+type ApiResourceLocationCollection struct {
+	Count *int                   `json:"count,omitempty"`
+	Value *[]ApiResourceLocation `json:"value,omitempty"`
+}
+
+// ApiVersionNegotiator lowers the version requested by a call to the highest version supported by the area of the
+// REST APIs it targets, so that the calls written against Azure DevOps Services also work with older Azure DevOps
+// Server collections. An area is named after the path segment following '_apis', e.g. 'graph' or 'wit'.
+type ApiVersionNegotiator struct {
+	areas      map[string]*apiAreaVersion
+	client     *RestClient
+	mutex      sync.Mutex
+	negotiated bool
+	overrides  map[string]string
+	retryAt    time.Time
+}
+
+type apiAreaVersion struct {
+	maxVersion      apiVersion
+	releasedVersion apiVersion
+	resourceVersion int
+}
+
+type apiVersion struct {
+	major int
+	minor int
+}
+
+// detachedContext keeps the values of a context, e.g. its logger, without its deadline and cancellation, for the work
+// shared by all the calls which must not fail because the call which started it was canceled.
+type detachedContext struct {
+	context.Context
+}
+
+// WithApiVersionNegotiation returns a copy of the client whose calls use the highest version supported by each
+// area, learned from the resource locations of the client on first use. The versions of overrides, keyed by area,
+// are used as-is whatever the requested and supported versions.
+func (c *RestClient) WithApiVersionNegotiation(overrides map[string]string) *RestClient {
+	normalizedOverrides := map[string]string{}
+	for area, version := range overrides {
+		normalizedOverrides[strings.ToLower(area)] = version
+	}
+
+	client := *c
+	client.apiVersions = &ApiVersionNegotiator{
+		client:    c,
+		overrides: normalizedOverrides,
+	}
+	return &client
+}
+
+// Private Methods
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+// getApiArea returns the area of a call, named after the path segment following '_apis'.
+func getApiArea(segments []string) string {
+	for i, segment := range segments {
+		if segment == "_apis" && i+1 < len(segments) {
+			return strings.ToLower(segments[i+1])
+		}
+	}
+	return ""
+}
+
+// getLocationArea returns the area of the calls served by a resource location. The route templates of Azure DevOps
+// use the '{area}' and '{resource}' placeholders for the segment following '_apis', e.g. '_apis/{area}/{resource}'.
+func getLocationArea(location *ApiResourceLocation) string {
+	segments := strings.Split(*location.RouteTemplate, "/")
+	for i, segment := range segments {
+		if segment != "_apis" || i+1 >= len(segments) {
+			continue
+		}
+
+		switch next := strings.ToLower(segments[i+1]); {
+		case next == "{area}" && location.Area != nil:
+			return strings.ToLower(*location.Area)
+		case next == "{resource}" && location.ResourceName != nil:
+			return strings.ToLower(*location.ResourceName)
+		case strings.HasPrefix(next, "{"):
+			return ""
+		default:
+			return next
+		}
+	}
+	return ""
+}
+
+func parseApiVersion(version string) (apiVersion, bool) {
+	matches := apiVersionRegexp.FindStringSubmatch(version)
+	if matches == nil {
+		return apiVersion{}, false
+	}
+
+	major, _ := strconv.Atoi(matches[1])
+	minor, _ := strconv.Atoi(matches[2])
+	return apiVersion{major: major, minor: minor}, true
+}
+
+func (v apiVersion) less(other apiVersion) bool {
+	return v.major < other.major || (v.major == other.major && v.minor < other.minor)
+}
+
+func (v apiVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.major, v.minor)
+}
+
+// getAreaVersion returns the versions supported by an area, negotiated on first use. The negotiation is shared by all
+// calls, so it is not canceled along with the call which started it, and is attempted again later when it failed.
+func (n *ApiVersionNegotiator) getAreaVersion(ctx context.Context, area string) (*apiAreaVersion, bool) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if !n.negotiated && !time.Now().Before(n.retryAt) {
+		if err := n.negotiate(detachedContext{ctx}); err != nil {
+			logger.Warn(ctx, "Unable to negotiate the versions of the REST APIs, using the versions requested by each call: "+err.Error())
+			n.retryAt = time.Now().Add(apiVersionNegotiationRetryDelay)
+		} else {
+			n.negotiated = true
+		}
+	}
+
+	supported, ok := n.areas[area]
+	return supported, ok
+}
+
+// negotiate reads the resource locations of the organization, and keeps the highest version supported by each area.
+func (n *ApiVersionNegotiator) negotiate(ctx context.Context) error {
+	pathSegments := []string{"_apis"}
+	locations, _, err := sendRequestJSON[ApiResourceLocationCollection](n.client, ctx, http.MethodOptions, pathSegments, nil, nil, nil, "")
+	if err != nil {
+		return err
+	}
+
+	areas := map[string]*apiAreaVersion{}
+	if locations != nil && locations.Value != nil {
+		for _, location := range *locations.Value {
+			if location.RouteTemplate == nil || location.MaxVersion == nil {
+				continue
+			}
+
+			area := getLocationArea(&location)
+			maxVersion, ok := parseApiVersion(*location.MaxVersion)
+			if area == "" || !ok {
+				continue
+			}
+
+			if current, ok := areas[area]; ok && !current.maxVersion.less(maxVersion) {
+				continue
+			}
+
+			releasedVersion := maxVersion
+			if location.ReleasedVersion != nil {
+				releasedVersion, _ = parseApiVersion(*location.ReleasedVersion)
+			}
+			resourceVersion := 1
+			if location.ResourceVersion != nil {
+				resourceVersion = *location.ResourceVersion
+			}
+			areas[area] = &apiAreaVersion{maxVersion: maxVersion, releasedVersion: releasedVersion, resourceVersion: resourceVersion}
+		}
+	}
+
+	logger.Debug(ctx, "Negotiated the versions of the REST APIs", map[string]any{
+		"api_areas": len(areas),
+	})
+	n.areas = areas
+	return nil
+}
+
+// resolve returns the version to use for a call: the override of its area, the requested version when supported, or
+// the highest supported version, which is a preview version when it has not been released yet.
+func (n *ApiVersionNegotiator) resolve(ctx context.Context, pathSegments []string, requested string) string {
+	if n == nil || requested == "" {
+		return requested
+	}
+
+	area := getApiArea(pathSegments)
+	if area == "" {
+		return requested
+	}
+
+	if version, ok := n.overrides[area]; ok {
+		return version
+	}
+
+	supported, ok := n.getAreaVersion(ctx, area)
+	if !ok {
+		return requested
+	}
+
+	version, ok := parseApiVersion(requested)
+	if !ok || !supported.maxVersion.less(version) {
+		return requested
+	}
+
+	negotiated := supported.maxVersion.String()
+	if supported.releasedVersion.less(supported.maxVersion) {
+		negotiated += fmt.Sprintf("-preview.%d", supported.resourceVersion)
+	}
+	logger.Debug(ctx, "Lowered the version of the REST APIs to the highest one supported", map[string]any{
+		"api_area":              area,
+		"api_version":           negotiated,
+		"api_version_requested": requested,
+	})
+	return negotiated
+}
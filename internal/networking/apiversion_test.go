@@ -0,0 +1,91 @@
+package networking
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetLocationArea(t *testing.T) {
+	cases := []struct {
+		location ApiResourceLocation
+		expected string
+	}{
+		{location: ApiResourceLocation{Area: stringPtr("wit"), ResourceName: stringPtr("fields"), RouteTemplate: stringPtr("{project}/_apis/{area}/{resource}/{fieldNameOrRefName}")}, expected: "wit"},
+		{location: ApiResourceLocation{Area: stringPtr("Graph"), ResourceName: stringPtr("groups"), RouteTemplate: stringPtr("_apis/{area}/{resource}/{groupDescriptor}")}, expected: "graph"},
+		{location: ApiResourceLocation{Area: stringPtr("core"), ResourceName: stringPtr("projects"), RouteTemplate: stringPtr("_apis/{resource}/{*projectId}")}, expected: "projects"},
+		{location: ApiResourceLocation{Area: stringPtr("core"), ResourceName: stringPtr("projects"), RouteTemplate: stringPtr("_apis/projects/{*projectId}")}, expected: "projects"},
+		{location: ApiResourceLocation{Area: stringPtr("Location"), RouteTemplate: stringPtr("_apis/{controller}")}, expected: ""},
+		{location: ApiResourceLocation{Area: stringPtr("wit"), RouteTemplate: stringPtr("{project}/{team}")}, expected: ""},
+	}
+	for _, c := range cases {
+		if area := getLocationArea(&c.location); area != c.expected {
+			t.Errorf("route template '%s': expected area '%s', got '%s'", *c.location.RouteTemplate, c.expected, area)
+		}
+	}
+}
+
+func TestApiVersionNegotiator_Placeholders(t *testing.T) {
+	server := newLocationsServer(t, func() bool { return true })
+	client := newNegotiatingClient(server)
+
+	cases := map[string][]string{
+		"6.0":           {"project", "_apis", "wit", "fields"},
+		"5.1-preview.2": {"_apis", "graph", "groups"},
+		"7.0":           {"_apis", "projects"},
+	}
+	for expected, pathSegments := range cases {
+		if version := client.apiVersions.resolve(context.Background(), pathSegments, ApiVersion70); version != expected {
+			t.Errorf("%v: expected version '%s', got '%s'", pathSegments, expected, version)
+		}
+	}
+}
+
+func TestApiVersionNegotiator_RetriesFailedNegotiation(t *testing.T) {
+	available := false
+	server := newLocationsServer(t, func() bool { return available })
+	client := newNegotiatingClient(server)
+	pathSegments := []string{"project", "_apis", "wit", "fields"}
+
+	if version := client.apiVersions.resolve(context.Background(), pathSegments, ApiVersion70); version != ApiVersion70 {
+		t.Fatalf("expected the requested version when the negotiation fails, got '%s'", version)
+	}
+	if client.apiVersions.negotiated {
+		t.Fatal("the failed negotiation was marked as done")
+	}
+
+	// The negotiation is attempted again once the retry delay is over, even when the call which triggers it is canceled
+	available = true
+	client.apiVersions.retryAt = time.Now()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if version := client.apiVersions.resolve(ctx, pathSegments, ApiVersion70); version != "6.0" {
+		t.Fatalf("expected the negotiated version '6.0', got '%s'", version)
+	}
+	if !client.apiVersions.negotiated {
+		t.Fatal("the successful negotiation was not marked as done")
+	}
+}
+
+func newLocationsServer(t *testing.T, available func() bool) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodOptions || !available() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count":3,"value":[
+			{"area":"wit","resourceName":"fields","routeTemplate":"{project}/_apis/{area}/{resource}/{fieldNameOrRefName}","maxVersion":"6.0","releasedVersion":"6.0","resourceVersion":2},
+			{"area":"Graph","resourceName":"groups","routeTemplate":"_apis/{area}/{resource}/{groupDescriptor}","maxVersion":"5.1","releasedVersion":"5.0","resourceVersion":2},
+			{"area":"core","resourceName":"projects","routeTemplate":"_apis/{resource}/{*projectId}","maxVersion":"7.1","releasedVersion":"7.1","resourceVersion":4}
+		]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newNegotiatingClient(server *httptest.Server) *RestClient {
+	return NewRestClient(server.Client(), server.URL, NewPersonalAccessTokenProvider("test"), "test", nil).WithApiVersionNegotiation(nil)
+}
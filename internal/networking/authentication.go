@@ -0,0 +1,64 @@
+package networking
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"time"
+)
+
+const tokenRefreshSkew = 5 * time.Minute
+
+// TokenProvider supplies the Authorization header sent with every request.
+type TokenProvider interface {
+	GetAuthorization(ctx context.Context) (string, error)
+}
+
+type PersonalAccessTokenProvider struct {
+	authorization string
+}
+
+func NewPersonalAccessTokenProvider(personalAccessToken string) *PersonalAccessTokenProvider {
+	return &PersonalAccessTokenProvider{
+		authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte(":"+personalAccessToken)),
+	}
+}
+
+func (p *PersonalAccessTokenProvider) GetAuthorization(_ context.Context) (string, error) {
+	return p.authorization, nil
+}
+
+type AccessToken struct {
+	ExpiresOn time.Time
+	Value     string
+}
+
+type AccessTokenFunc func(ctx context.Context) (*AccessToken, error)
+
+// BearerTokenProvider caches an access token and refreshes it shortly before it expires.
+type BearerTokenProvider struct {
+	fetch AccessTokenFunc
+	lock  sync.Mutex
+	token *AccessToken
+}
+
+func NewBearerTokenProvider(fetch AccessTokenFunc) *BearerTokenProvider {
+	return &BearerTokenProvider{
+		fetch: fetch,
+	}
+}
+
+func (p *BearerTokenProvider) GetAuthorization(ctx context.Context) (string, error) {
+	defer p.lock.Unlock()
+	p.lock.Lock()
+
+	if p.token == nil || time.Now().Add(tokenRefreshSkew).After(p.token.ExpiresOn) {
+		token, err := p.fetch(ctx)
+		if err != nil {
+			return "", err
+		}
+		p.token = token
+	}
+
+	return "Bearer " + p.token.Value, nil
+}
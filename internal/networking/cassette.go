@@ -0,0 +1,230 @@
+package networking
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	CassetteModeRecord CassetteMode = "record"
+	CassetteModeReplay CassetteMode = "replay"
+)
+
+type CassetteMode string
+
+// Cassette is the list of the HTTP interactions recorded from an organization, replayed in the same order.
+type Cassette struct {
+	Interactions []*CassetteInteraction `json:"interactions"`
+}
+
+type CassetteInteraction struct {
+	Request  *CassetteRequest  `json:"request"`
+	Response *CassetteResponse `json:"response"`
+}
+
+type CassetteRequest struct {
+	Body    string      `json:"body,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Method  string      `json:"method"`
+	Url     string      `json:"url"`
+}
+
+type CassetteResponse struct {
+	Body       string      `json:"body,omitempty"`
+	Headers    http.Header `json:"headers,omitempty"`
+	StatusCode int         `json:"statusCode"`
+}
+
+// CassetteTransport records the interactions sent through another transport to a cassette file, or replays the
+// interactions of a cassette file without sending any request. Secrets are scrubbed before being recorded.
+type CassetteTransport struct {
+	recorder  *cassetteRecorder
+	transport http.RoundTripper
+}
+
+// cassetteRecorder holds the interactions of a cassette file. The provider is configured again for every Terraform
+// command, so the transports of a process opened on the same file share the same recorder.
+type cassetteRecorder struct {
+	cassette *Cassette
+	mode     CassetteMode
+	mu       sync.Mutex
+	path     string
+	replayed []bool
+}
+
+var (
+	cassetteRecorders   = map[string]*cassetteRecorder{}
+	cassetteRecordersMu sync.Mutex
+)
+
+func NewCassetteTransport(path string, mode CassetteMode, transport http.RoundTripper) (*CassetteTransport, error) {
+	if path == "" {
+		return nil, errors.New("the path of the cassette is required")
+	}
+
+	cassetteRecordersMu.Lock()
+	defer cassetteRecordersMu.Unlock()
+
+	key := string(mode) + ":" + filepath.Clean(path)
+	if recorder, ok := cassetteRecorders[key]; ok {
+		return &CassetteTransport{recorder: recorder, transport: transport}, nil
+	}
+
+	recorder := &cassetteRecorder{
+		cassette: &Cassette{},
+		mode:     mode,
+		path:     path,
+	}
+
+	switch mode {
+	case CassetteModeRecord:
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+	case CassetteModeReplay:
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cassette '%s': %w", path, err)
+		}
+		if err = json.Unmarshal(content, recorder.cassette); err != nil {
+			return nil, fmt.Errorf("unable to parse cassette '%s': %w", path, err)
+		}
+		recorder.replayed = make([]bool, len(recorder.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unknown cassette mode '%s', must be '%s' or '%s'", mode, CassetteModeRecord, CassetteModeReplay)
+	}
+
+	cassetteRecorders[key] = recorder
+	return &CassetteTransport{recorder: recorder, transport: transport}, nil
+}
+
+func (t *CassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &CassetteRequest{
+		Body:    scrubBody(body, req.Header.Get(headerKeyContentType)),
+		Headers: scrubHeaders(req.Header),
+		Method:  req.Method,
+		Url:     scrubUrl(req.URL),
+	}
+
+	if t.recorder.mode == CassetteModeReplay {
+		return t.recorder.replay(req, request)
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	return t.recorder.record(resp, request)
+}
+
+// Private Methods
+
+func (r *cassetteRecorder) record(resp *http.Response, request *CassetteRequest) (*http.Response, error) {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &CassetteInteraction{
+		Request: request,
+		Response: &CassetteResponse{
+			Body:       scrubBody(body, resp.Header.Get(headerKeyContentType)),
+			Headers:    scrubHeaders(resp.Header),
+			StatusCode: resp.StatusCode,
+		},
+	})
+
+	// The cassette is saved after every interaction, so it is complete even if the process is interrupted
+	content, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = os.WriteFile(r.path, content, 0o644); err != nil {
+		return nil, fmt.Errorf("unable to write cassette '%s': %w", r.path, err)
+	}
+
+	return resp, nil
+}
+
+// replay returns the response of the first interaction not replayed yet whose request matches, so that the responses
+// of a polled or paged request are returned in the recorded order.
+func (r *cassetteRecorder) replay(req *http.Request, request *CassetteRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := cassetteMatchKey(request)
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || cassetteMatchKey(interaction.Request) != key {
+			continue
+		}
+
+		r.replayed[i] = true
+		body := []byte(interaction.Response.Body)
+		return &http.Response{
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Header:        interaction.Response.Headers.Clone(),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Request:       req,
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no interaction of cassette '%s' matches the request %s %s", r.path, request.Method, request.Url)
+}
+
+// cassetteMatchKey identifies a request regardless of the order of its query parameters and of its continuation
+// token, whose value changes from one recording to another.
+func cassetteMatchKey(request *CassetteRequest) string {
+	u, err := url.Parse(request.Url)
+	if err != nil {
+		return request.Method + " " + request.Url + " " + request.Body
+	}
+
+	query := u.Query()
+	query.Del("continuationToken")
+	key := strings.ToUpper(request.Method) + " " + strings.ToLower(u.Host) + u.EscapedPath() + "?" + query.Encode()
+
+	var body any
+	if err = json.Unmarshal([]byte(request.Body), &body); err == nil {
+		normalized, _ := json.Marshal(body)
+		return key + " " + string(normalized)
+	}
+	return key + " " + request.Body
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -0,0 +1,124 @@
+package networking
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteTransport_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=cookie-secret")
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`{"id":"created","authorization":{"parameters":{"password":"response-secret"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"page":"` + r.URL.Query().Get("continuationToken") + `"}`))
+	}))
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewCassetteTransport(path, CassetteModeRecord, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(t, recorder, http.MethodGet, server.URL+"/_apis/projects?b=2&a=1&continuationToken=first", "")
+	send(t, recorder, http.MethodPost, server.URL+"/_apis/serviceendpoints", `{"name":"endpoint","authorization":{"parameters":{"password":"request-secret"}}}`)
+	server.Close()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"pat-secret", "cookie-secret", "request-secret", "response-secret"} {
+		if strings.Contains(string(content), secret) {
+			t.Errorf("the cassette contains the secret '%s'", secret)
+		}
+	}
+	if !strings.Contains(string(content), redactedValue) {
+		t.Error("the cassette contains no redacted value")
+	}
+
+	// The server is closed, so the responses can only come from the cassette
+	replayer, err := NewCassetteTransport(path, CassetteModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := send(t, replayer, http.MethodGet, server.URL+"/_apis/projects?continuationToken=second&a=1&b=2", ""); body != `{"page":"first"}` {
+		t.Errorf("unexpected replayed body '%s'", body)
+	}
+	if body := send(t, replayer, http.MethodPost, server.URL+"/_apis/serviceendpoints", `{"authorization":{"parameters":{"password":"other-secret"}},"name":"endpoint"}`); !strings.Contains(body, `"id":"created"`) {
+		t.Errorf("unexpected replayed body '%s'", body)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/_apis/projects?a=1&b=2", nil)
+	if _, err = replayer.RoundTrip(req); err == nil {
+		t.Error("an interaction was replayed twice")
+	}
+}
+
+func TestCassetteMatchKey(t *testing.T) {
+	cases := []struct {
+		first  *CassetteRequest
+		second *CassetteRequest
+		match  bool
+	}{
+		{
+			first:  &CassetteRequest{Method: "GET", Url: "https://dev.azure.com/contoso/_apis/projects?a=1&b=2"},
+			second: &CassetteRequest{Method: "get", Url: "https://DEV.azure.com/contoso/_apis/projects?b=2&a=1"},
+			match:  true,
+		},
+		{
+			first:  &CassetteRequest{Method: "GET", Url: "https://dev.azure.com/contoso/_apis/projects?continuationToken=1"},
+			second: &CassetteRequest{Method: "GET", Url: "https://dev.azure.com/contoso/_apis/projects?continuationToken=2"},
+			match:  true,
+		},
+		{
+			first:  &CassetteRequest{Body: `{"a":1,"b":2}`, Method: "POST", Url: "https://dev.azure.com/contoso/_apis/projects"},
+			second: &CassetteRequest{Body: `{"b":2, "a":1}`, Method: "POST", Url: "https://dev.azure.com/contoso/_apis/projects"},
+			match:  true,
+		},
+		{
+			first:  &CassetteRequest{Method: "GET", Url: "https://dev.azure.com/contoso/_apis/projects?a=1"},
+			second: &CassetteRequest{Method: "GET", Url: "https://dev.azure.com/contoso/_apis/projects?a=2"},
+			match:  false,
+		},
+		{
+			first:  &CassetteRequest{Body: `{"a":1}`, Method: "POST", Url: "https://dev.azure.com/contoso/_apis/projects"},
+			second: &CassetteRequest{Body: `{"a":2}`, Method: "POST", Url: "https://dev.azure.com/contoso/_apis/projects"},
+			match:  false,
+		},
+	}
+	for i, c := range cases {
+		if match := cassetteMatchKey(c.first) == cassetteMatchKey(c.second); match != c.match {
+			t.Errorf("case %d: expected match %t, got %t", i, c.match, match)
+		}
+	}
+}
+
+func send(t *testing.T, transport http.RoundTripper, method string, url string, body string) string {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Basic pat-secret")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
@@ -2,14 +2,30 @@ package networking
 
 const (
 	headerKeyAccept               = "Accept"
+	headerKeyActivityId           = "X-VSS-ActivityId"
 	headerKeyAuthorization        = "Authorization"
 	headerKeyContentType          = "Content-Type"
 	HeaderKeyContinuationToken    = "X-MS-ContinuationToken"
+	headerKeyRateLimitDelay       = "X-RateLimit-Delay"
+	headerKeyRateLimitReset       = "X-RateLimit-Reset"
+	headerKeyRateLimitResource    = "X-RateLimit-Resource"
+	headerKeyRetryAfter           = "Retry-After"
 	headerKeyUserAgent            = "User-Agent"
 	mediaTypeApplicationJson      = "application/json"
 	mediaTypeApplicationJsonPatch = "application/json-patch+json"
+	mediaTypeTextHtml             = "text/html"
 	mediaTypeTextPlain            = "text/plain"
 
+	logFieldActivityId = "activity_id"
+	logFieldAttempt    = "attempt"
+	logFieldBody       = "http_body"
+	logFieldDuration   = "duration_ms"
+	logFieldError      = "error"
+	logFieldMethod     = "http_method"
+	logFieldRequestId  = "request_id"
+	logFieldStatusCode = "http_status_code"
+	logFieldUrl        = "http_url"
+
 	ApiVersion70         = "7.0"
 	ApiVersion70Preview1 = "7.0-preview.1"
 	ApiVersion71Preview1 = "7.1-preview.1"
@@ -0,0 +1,327 @@
+package networking
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// AzureDevOpsResourceId is the Entra ID application ID of Azure DevOps.
+	AzureDevOpsResourceId = "499b84ac-1321-427f-aa17-267ca6975798"
+	DefaultAuthorityHost  = "https://login.microsoftonline.com/"
+	DefaultMsiEndpoint    = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	oidcAudience        = "api://AzureADTokenExchange"
+)
+
+type ClientAssertionFunc func(ctx context.Context) (string, error)
+
+type tokenResponse struct {
+	AccessToken      string      `json:"access_token"`
+	Error            string      `json:"error"`
+	ErrorDescription string      `json:"error_description"`
+	ExpiresIn        json.Number `json:"expires_in"`
+	ExpiresOn        json.Number `json:"expires_on"`
+}
+
+// NewClientSecretTokenProvider authenticates a service principal with a client secret.
+func NewClientSecretTokenProvider(httpClient *http.Client, authorityHost string, tenantId string, clientId string, clientSecret string) TokenProvider {
+	return NewBearerTokenProvider(func(ctx context.Context) (*AccessToken, error) {
+		form := url.Values{
+			"client_id":     []string{clientId},
+			"client_secret": []string{clientSecret},
+		}
+		return requestEntraIdToken(ctx, httpClient, authorityHost, tenantId, form)
+	})
+}
+
+// NewClientCertificateTokenProvider authenticates a service principal with a PEM encoded certificate and its
+// RSA private key.
+func NewClientCertificateTokenProvider(httpClient *http.Client, authorityHost string, tenantId string, clientId string, certificate []byte) (TokenProvider, error) {
+	cert, key, err := parseCertificate(certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientAssertionTokenProvider(httpClient, authorityHost, tenantId, clientId, func(_ context.Context) (string, error) {
+		return signClientAssertion(entraIdTokenEndpoint(authorityHost, tenantId), clientId, cert, key)
+	}), nil
+}
+
+// NewClientAssertionTokenProvider authenticates a service principal with a signed JWT assertion. This is the
+// flow used by workload identity federation where the assertion is an OIDC token issued by a trusted party.
+func NewClientAssertionTokenProvider(httpClient *http.Client, authorityHost string, tenantId string, clientId string, assertion ClientAssertionFunc) TokenProvider {
+	return NewBearerTokenProvider(func(ctx context.Context) (*AccessToken, error) {
+		clientAssertion, err := assertion(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		form := url.Values{
+			"client_assertion":      []string{clientAssertion},
+			"client_assertion_type": []string{clientAssertionType},
+			"client_id":             []string{clientId},
+		}
+		return requestEntraIdToken(ctx, httpClient, authorityHost, tenantId, form)
+	})
+}
+
+// NewManagedIdentityTokenProvider authenticates with the managed identity of the host, either through the
+// instance metadata service or through the identity endpoint exposed by App Service and Container Apps.
+func NewManagedIdentityTokenProvider(httpClient *http.Client, endpoint string, clientId string) TokenProvider {
+	return NewBearerTokenProvider(func(ctx context.Context) (*AccessToken, error) {
+		tokenEndpoint := endpoint
+		queryParams := url.Values{"resource": []string{AzureDevOpsResourceId}}
+		headers := map[string]string{}
+		if identityEndpoint, identityHeader := os.Getenv("IDENTITY_ENDPOINT"), os.Getenv("IDENTITY_HEADER"); tokenEndpoint == "" && identityEndpoint != "" && identityHeader != "" {
+			tokenEndpoint = identityEndpoint
+			headers["X-IDENTITY-HEADER"] = identityHeader
+			queryParams.Add("api-version", "2019-08-01")
+		} else {
+			if tokenEndpoint == "" {
+				tokenEndpoint = DefaultMsiEndpoint
+			}
+			headers["Metadata"] = "true"
+			queryParams.Add("api-version", "2018-02-01")
+		}
+		if clientId != "" {
+			queryParams.Add("client_id", clientId)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenEndpoint+"?"+queryParams.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range headers {
+			req.Header.Add(k, v)
+		}
+		return sendTokenRequest(httpClient, req, "managed identity")
+	})
+}
+
+// OidcTokenFromFile reads the OIDC token from a file every time a new access token is requested, which allows
+// the token to be rotated by the platform (e.g. Kubernetes projected service account tokens).
+func OidcTokenFromFile(path string) ClientAssertionFunc {
+	return func(_ context.Context) (string, error) {
+		token, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("unable to read OIDC token file '%s': %w", path, err)
+		}
+		return strings.TrimSpace(string(token)), nil
+	}
+}
+
+// OidcTokenFromRequest requests an OIDC token from a CI/CD platform. When a service connection ID is provided,
+// the request URL is assumed to be the Azure Pipelines OIDC endpoint, otherwise the GitHub Actions one.
+func OidcTokenFromRequest(httpClient *http.Client, requestUrl string, requestToken string, serviceConnectionId string) ClientAssertionFunc {
+	return func(ctx context.Context) (string, error) {
+		var req *http.Request
+		var err error
+		if serviceConnectionId != "" {
+			queryParams := url.Values{"api-version": []string{"7.1"}, "serviceConnectionId": []string{serviceConnectionId}}
+			req, err = http.NewRequestWithContext(ctx, http.MethodPost, appendQuery(requestUrl, queryParams), nil)
+		} else {
+			queryParams := url.Values{"audience": []string{oidcAudience}}
+			req, err = http.NewRequestWithContext(ctx, http.MethodGet, appendQuery(requestUrl, queryParams), nil)
+		}
+		if err != nil {
+			return "", err
+		}
+		req.Header.Add(headerKeyAccept, mediaTypeApplicationJson)
+		req.Header.Add(headerKeyAuthorization, "Bearer "+requestToken)
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unable to request OIDC token (status: %d): %s", resp.StatusCode, string(body))
+		}
+
+		var result struct {
+			OidcToken string `json:"oidcToken"`
+			Value     string `json:"value"`
+		}
+		if err = json.Unmarshal(body, &result); err != nil {
+			return "", err
+		}
+		if result.OidcToken != "" {
+			return result.OidcToken, nil
+		}
+		if result.Value != "" {
+			return result.Value, nil
+		}
+		return "", errors.New("OIDC token response does not contain any token")
+	}
+}
+
+// OidcTokenFromValue always returns the same OIDC token.
+func OidcTokenFromValue(token string) ClientAssertionFunc {
+	return func(_ context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// Private Methods
+
+func appendQuery(requestUrl string, queryParams url.Values) string {
+	if strings.Contains(requestUrl, "?") {
+		return requestUrl + "&" + queryParams.Encode()
+	}
+	return requestUrl + "?" + queryParams.Encode()
+}
+
+func entraIdTokenEndpoint(authorityHost string, tenantId string) string {
+	if authorityHost == "" {
+		authorityHost = DefaultAuthorityHost
+	}
+	return strings.TrimSuffix(authorityHost, "/") + "/" + url.PathEscape(tenantId) + "/oauth2/v2.0/token"
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	var cert *x509.Certificate
+	var key *rsa.PrivateKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			if cert != nil {
+				continue
+			}
+			c, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+			cert = c
+		case "RSA PRIVATE KEY":
+			k, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+			key = k
+		case "PRIVATE KEY":
+			k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+			rsaKey, ok := k.(*rsa.PrivateKey)
+			if !ok {
+				return nil, nil, errors.New("client certificate private key must be an RSA key")
+			}
+			key = rsaKey
+		}
+	}
+
+	if cert == nil || key == nil {
+		return nil, nil, errors.New("client certificate must be PEM encoded and contain both the certificate and its private key")
+	}
+	return cert, key, nil
+}
+
+func requestEntraIdToken(ctx context.Context, httpClient *http.Client, authorityHost string, tenantId string, form url.Values) (*AccessToken, error) {
+	form.Add("grant_type", "client_credentials")
+	form.Add("scope", AzureDevOpsResourceId+"/.default")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, entraIdTokenEndpoint(authorityHost, tenantId), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add(headerKeyContentType, "application/x-www-form-urlencoded")
+	return sendTokenRequest(httpClient, req, "Entra ID")
+}
+
+func sendTokenRequest(httpClient *http.Client, req *http.Request, source string) (*AccessToken, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to request %s access token: %w", source, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var token tokenResponse
+	if err = json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("unable to parse %s token response (status: %d): %w", source, resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		return nil, fmt.Errorf("unable to request %s access token (status: %d): %s %s", source, resp.StatusCode, token.Error, token.ErrorDescription)
+	}
+
+	expiresOn := time.Now().Add(time.Hour)
+	if seconds, err := strconv.ParseInt(token.ExpiresOn.String(), 10, 64); err == nil {
+		expiresOn = time.Unix(seconds, 0)
+	} else if seconds, err := strconv.ParseInt(token.ExpiresIn.String(), 10, 64); err == nil {
+		expiresOn = time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+
+	return &AccessToken{
+		ExpiresOn: expiresOn,
+		Value:     token.AccessToken,
+	}, nil
+}
+
+func signClientAssertion(audience string, clientId string, cert *x509.Certificate, key *rsa.PrivateKey) (string, error) {
+	thumbprint := sha1.Sum(cert.Raw)
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims, err := json.Marshal(map[string]any{
+		"aud": audience,
+		"exp": now.Add(10 * time.Minute).Unix(),
+		"iss": clientId,
+		"jti": uuid.NewString(),
+		"nbf": now.Unix(),
+		"sub": clientId,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,324 @@
+package networking
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestManagedIdentityTokenProvider_IdentityEndpointOnEveryRefresh(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-IDENTITY-HEADER") != "secret" || r.Header.Get("Metadata") != "" {
+			t.Errorf("request %d: unexpected headers %v", requests, r.Header)
+		}
+		if apiVersion := r.URL.Query().Get("api-version"); apiVersion != "2019-08-01" {
+			t.Errorf("request %d: unexpected api-version '%s'", requests, apiVersion)
+		}
+		// Expires within the refresh skew, so every call requests a new token
+		_, _ = w.Write([]byte(`{"access_token":"token","expires_in":"60"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("IDENTITY_ENDPOINT", server.URL)
+	t.Setenv("IDENTITY_HEADER", "secret")
+
+	provider := NewManagedIdentityTokenProvider(server.Client(), "", "")
+	for i := 0; i < 2; i++ {
+		authorization, err := provider.GetAuthorization(context.Background())
+		if err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if authorization != "Bearer token" {
+			t.Fatalf("call %d: unexpected authorization '%s'", i, authorization)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 token requests, got %d", requests)
+	}
+}
+
+func TestEntraIdTokenEndpoint_AuthorityHost(t *testing.T) {
+	cases := map[string]string{
+		"":                                  "https://login.microsoftonline.com/tenant/oauth2/v2.0/token",
+		"https://login.microsoftonline.us/": "https://login.microsoftonline.us/tenant/oauth2/v2.0/token",
+		"https://login.chinacloudapi.cn":    "https://login.chinacloudapi.cn/tenant/oauth2/v2.0/token",
+	}
+	for authorityHost, expected := range cases {
+		if endpoint := entraIdTokenEndpoint(authorityHost, "tenant"); endpoint != expected {
+			t.Errorf("authority host '%s': expected '%s', got '%s'", authorityHost, expected, endpoint)
+		}
+	}
+}
+
+func TestBearerTokenProvider_RefreshSkew(t *testing.T) {
+	cases := []struct {
+		expiresIn        time.Duration
+		expectedRequests int
+	}{
+		{expiresIn: tokenRefreshSkew + time.Minute, expectedRequests: 1},
+		{expiresIn: tokenRefreshSkew - time.Minute, expectedRequests: 3},
+	}
+	for _, c := range cases {
+		requests := 0
+		provider := NewBearerTokenProvider(func(_ context.Context) (*AccessToken, error) {
+			requests++
+			return &AccessToken{ExpiresOn: time.Now().Add(c.expiresIn), Value: "token"}, nil
+		})
+		for i := 0; i < 3; i++ {
+			if _, err := provider.GetAuthorization(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if requests != c.expectedRequests {
+			t.Errorf("token expiring in %s: expected %d token requests, got %d", c.expiresIn, c.expectedRequests, requests)
+		}
+	}
+}
+
+func TestClientSecretTokenProvider(t *testing.T) {
+	requests := 0
+	server := newTokenServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		expected := map[string]string{
+			"client_id":     "client",
+			"client_secret": "secret",
+			"grant_type":    "client_credentials",
+			"scope":         AzureDevOpsResourceId + "/.default",
+		}
+		for key, value := range expected {
+			if actual := r.PostForm.Get(key); actual != value {
+				t.Errorf("expected the form value %s '%s', got '%s'", key, value, actual)
+			}
+		}
+		_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+	})
+
+	provider := NewClientSecretTokenProvider(server.Client(), server.URL+"/", "tenant", "client", "secret")
+	for i := 0; i < 2; i++ {
+		authorization, err := provider.GetAuthorization(context.Background())
+		if err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if authorization != "Bearer token" {
+			t.Fatalf("call %d: unexpected authorization '%s'", i, authorization)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected the token to be cached, got %d token requests", requests)
+	}
+}
+
+func TestClientSecretTokenProvider_Error(t *testing.T) {
+	server := newTokenServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"AADSTS7000215: Invalid client secret provided."}`))
+	})
+
+	provider := NewClientSecretTokenProvider(server.Client(), server.URL, "tenant", "client", "secret")
+	_, err := provider.GetAuthorization(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "status: 401") || !strings.Contains(err.Error(), "AADSTS7000215") {
+		t.Fatalf("expected the error of Entra ID, got %v", err)
+	}
+}
+
+func TestClientCertificateTokenProvider(t *testing.T) {
+	for _, pkcs8 := range []bool{false, true} {
+		certificate, key, cert := newTestCertificate(t, pkcs8)
+		server := newTokenServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if assertionType := r.PostForm.Get("client_assertion_type"); assertionType != clientAssertionType {
+				t.Errorf("unexpected client assertion type '%s'", assertionType)
+			}
+			verifyClientAssertion(t, r.PostForm.Get("client_assertion"), cert, "https://"+r.Host+r.URL.Path)
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+		})
+
+		provider, err := NewClientCertificateTokenProvider(server.Client(), server.URL, "tenant", "client", append(certificate, key...))
+		if err != nil {
+			t.Fatalf("PKCS#8 %t: %v", pkcs8, err)
+		}
+		if authorization, err := provider.GetAuthorization(context.Background()); err != nil || authorization != "Bearer token" {
+			t.Fatalf("PKCS#8 %t: unexpected authorization '%s': %v", pkcs8, authorization, err)
+		}
+	}
+}
+
+func TestParseCertificate_Errors(t *testing.T) {
+	certificate, key, _ := newTestCertificate(t, false)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKeyBytes, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		data     []byte
+		expected string
+	}{
+		"missing key":         {data: certificate, expected: "contain both the certificate and its private key"},
+		"missing certificate": {data: key, expected: "contain both the certificate and its private key"},
+		"not PEM encoded":     {data: []byte("certificate"), expected: "contain both the certificate and its private key"},
+		"ECDSA key":           {data: append(certificate, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ecKeyBytes})...), expected: "must be an RSA key"},
+	}
+	for name, c := range cases {
+		if _, _, err := parseCertificate(c.data); err == nil || !strings.Contains(err.Error(), c.expected) {
+			t.Errorf("%s: expected an error containing '%s', got %v", name, c.expected, err)
+		}
+	}
+}
+
+func TestOidcTokenFromRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if authorization := r.Header.Get(headerKeyAuthorization); authorization != "Bearer request-token" {
+			t.Errorf("unexpected authorization '%s'", authorization)
+		}
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/pipelines":
+			if r.Method != http.MethodPost || query.Get("serviceConnectionId") != "connection" || query.Get("api-version") != "7.1" {
+				t.Errorf("unexpected Azure Pipelines request %s %s", r.Method, r.URL)
+			}
+			_, _ = w.Write([]byte(`{"oidcToken":"pipelines-token"}`))
+		case "/github":
+			if r.Method != http.MethodGet || query.Get("audience") != oidcAudience || query.Get("api-version") != "2.0" {
+				t.Errorf("unexpected GitHub Actions request %s %s", r.Method, r.URL)
+			}
+			_, _ = w.Write([]byte(`{"value":"github-token"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"forbidden"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cases := []struct {
+		requestUrl          string
+		serviceConnectionId string
+		expected            string
+	}{
+		{requestUrl: server.URL + "/pipelines", serviceConnectionId: "connection", expected: "pipelines-token"},
+		{requestUrl: server.URL + "/github?api-version=2.0", expected: "github-token"},
+	}
+	for _, c := range cases {
+		token, err := OidcTokenFromRequest(server.Client(), c.requestUrl, "request-token", c.serviceConnectionId)(context.Background())
+		if err != nil || token != c.expected {
+			t.Errorf("%s: expected the token '%s', got '%s': %v", c.requestUrl, c.expected, token, err)
+		}
+	}
+
+	if _, err := OidcTokenFromRequest(server.Client(), server.URL+"/other", "request-token", "")(context.Background()); err == nil || !strings.Contains(err.Error(), "status: 403") {
+		t.Errorf("expected the status of the failed request, got %v", err)
+	}
+}
+
+// newTestCertificate returns a self-signed certificate and its RSA private key, PEM encoded in the PKCS#1 or PKCS#8
+// format.
+func newTestCertificate(t *testing.T, pkcs8 bool) ([]byte, []byte, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		DNSNames:     []string{"localhost"},
+		NotAfter:     time.Now().Add(time.Hour),
+		NotBefore:    time.Now().Add(-time.Hour),
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-azuredevops"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyBlock := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if pkcs8 {
+		keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyBlock = &pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(keyBlock), cert
+}
+
+// newTokenServer starts a fake Entra ID token endpoint for the tenant 'tenant', which calls handle with the parsed
+// form of the token requests.
+func newTokenServer(t *testing.T, handle http.HandlerFunc) *httptest.Server {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/tenant/oauth2/v2.0/token" {
+			t.Errorf("unexpected token request %s %s", r.Method, r.URL)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set(headerKeyContentType, mediaTypeApplicationJson)
+		handle(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// verifyClientAssertion checks the claims of a client assertion and its signature by the private key of cert.
+func verifyClientAssertion(t *testing.T, assertion string, cert *x509.Certificate, audience string) {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		t.Errorf("expected a signed JWT, got '%s'", assertion)
+		return
+	}
+
+	var header map[string]string
+	var claims map[string]any
+	decode := func(part string, v any) {
+		data, err := base64.RawURLEncoding.DecodeString(part)
+		if err == nil {
+			err = json.Unmarshal(data, v)
+		}
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	decode(parts[0], &header)
+	decode(parts[1], &claims)
+
+	thumbprint := sha1.Sum(cert.Raw)
+	if header["alg"] != "RS256" || header["x5t"] != base64.RawURLEncoding.EncodeToString(thumbprint[:]) {
+		t.Errorf("unexpected JWT header %v", header)
+	}
+	if claims["aud"] != audience || claims["iss"] != "client" || claims["sub"] != "client" || claims["jti"] == "" {
+		t.Errorf("unexpected JWT claims %v", claims)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(cert.PublicKey.(*rsa.PublicKey), crypto.SHA256, digest[:], signature); err != nil {
+		t.Errorf("invalid JWT signature: %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Sentinel errors matched with errors.Is by the typed errors returned by the REST clients.
+var (
+	ErrConflict     = errors.New("conflict")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrThrottled    = errors.New("throttled")
+	ErrTransient    = errors.New("transient error")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrValidation   = errors.New("validation failed")
+)
+
+// Scopes of a personal access token are named like 'vso.project_manage', see
+// https://learn.microsoft.com/en-us/azure/devops/integrate/get-started/authentication/oauth#scopes
+var missingScopeRegexp = regexp.MustCompile(`\bvso\.[a-z_]+\b`)
+
+type ConflictError struct {
+	*WrappedError
+}
+
+// ForbiddenError is returned when the identity is authenticated but not allowed to perform the operation.
+// MissingScope is the scope the personal access token lacks, when Azure DevOps reports it.
+type ForbiddenError struct {
+	*WrappedError
+	MissingScope string
+}
+
+// NotFoundError is returned for a missing object, including the objects of a project which does not exist.
+type NotFoundError struct {
+	*WrappedError
+}
+
+// ThrottledError is returned when a request was still throttled after all its retries. RetryAfter is the delay
+// requested by Azure DevOps, zero when not sent.
+type ThrottledError struct {
+	*WrappedError
+	RetryAfter time.Duration
+}
+
+// TransientError is returned when a request still failed with a server or network error after all its retries. Err is
+// the network error, nil when Azure DevOps answered.
+type TransientError struct {
+	*WrappedError
+	Err error
+}
+
+type UnauthorizedError struct {
+	*WrappedError
+}
+
+// ValidationError is returned when Azure DevOps rejects a request, TypeKey and ErrorCode identifying the reason,
+// e.g. 'ProjectAlreadyExistsException'.
+type ValidationError struct {
+	*WrappedError
+}
+
+// IsRetryable returns whether the operation which failed with err may succeed if attempted again later.
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrThrottled) || errors.Is(err, ErrTransient)
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.WrappedError
+}
+
+func (e *ForbiddenError) Error() string {
+	if e.MissingScope == "" {
+		return e.WrappedError.Error() + " (the identity of the provider is not allowed to perform this operation)"
+	}
+	return fmt.Sprintf("%s (the personal access token is missing the scope '%s')", e.WrappedError.Error(), e.MissingScope)
+}
+
+func (e *ForbiddenError) Is(target error) bool {
+	return target == ErrForbidden
+}
+
+func (e *ForbiddenError) Unwrap() error {
+	return e.WrappedError
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.WrappedError
+}
+
+func (e *ThrottledError) Error() string {
+	if e.RetryAfter <= 0 {
+		return e.WrappedError.Error()
+	}
+	return fmt.Sprintf("%s (retry after %s)", e.WrappedError.Error(), e.RetryAfter)
+}
+
+func (e *ThrottledError) Is(target error) bool {
+	return target == ErrThrottled
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.WrappedError
+}
+
+func (e *TransientError) Is(target error) bool {
+	return target == ErrTransient
+}
+
+func (e *TransientError) Unwrap() error {
+	if e.Err != nil {
+		return e.Err
+	}
+	return e.WrappedError
+}
+
+func (e *UnauthorizedError) Error() string {
+	return e.WrappedError.Error() + " (check the credentials of the provider)"
+}
+
+func (e *UnauthorizedError) Is(target error) bool {
+	return target == ErrUnauthorized
+}
+
+func (e *UnauthorizedError) Unwrap() error {
+	return e.WrappedError
+}
+
+func (e *ValidationError) Error() string {
+	var reasons []string
+	if e.TypeKey != nil && *e.TypeKey != "" {
+		reasons = append(reasons, *e.TypeKey)
+	}
+	if e.ErrorCode != nil && *e.ErrorCode != 0 {
+		reasons = append(reasons, fmt.Sprintf("error code %d", *e.ErrorCode))
+	}
+	if len(reasons) == 0 {
+		return e.WrappedError.Error()
+	}
+	return fmt.Sprintf("%s (%s)", e.WrappedError.Error(), strings.Join(reasons, ", "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.WrappedError
+}
+
+// Private Methods
+
+// newApiError returns the typed error matching the status code of a failed response. Azure DevOps answers some
+// requests on the objects of a missing project with 400 and the VS800075 error, and the requests made with a personal
+// access token lacking a scope with 401, which are considered as not found and forbidden respectively. Rejected
+// credentials are answered with the 203 sign-in page.
+func newApiError(resp *http.Response, wrappedError *WrappedError) error {
+	message := ""
+	if wrappedError.Message != nil {
+		message = *wrappedError.Message
+	}
+
+	switch statusCode := resp.StatusCode; {
+	case statusCode == http.StatusNotFound:
+		return &NotFoundError{WrappedError: wrappedError}
+	case statusCode == http.StatusConflict:
+		return &ConflictError{WrappedError: wrappedError}
+	case statusCode == http.StatusNonAuthoritativeInfo:
+		return &UnauthorizedError{WrappedError: wrappedError}
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		missingScope := missingScopeRegexp.FindString(message)
+		if statusCode == http.StatusUnauthorized && missingScope == "" {
+			return &UnauthorizedError{WrappedError: wrappedError}
+		}
+		return &ForbiddenError{WrappedError: wrappedError, MissingScope: missingScope}
+	case statusCode == http.StatusTooManyRequests:
+		retryAfter, _ := throttlingDelay(resp)
+		return &ThrottledError{WrappedError: wrappedError, RetryAfter: retryAfter}
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		if strings.Contains(message, "VS800075") {
+			return &NotFoundError{WrappedError: wrappedError}
+		}
+		return &ValidationError{WrappedError: wrappedError}
+	case statusCode == http.StatusRequestTimeout || statusCode >= http.StatusInternalServerError:
+		return &TransientError{WrappedError: wrappedError}
+	default:
+		return wrappedError
+	}
+}
+
+// newTransientError returns the error of a request which failed without any response, typed when the failure is
+// transient.
+func newTransientError(err error) error {
+	if !isTransientError(err) {
+		return err
+	}
+
+	message := err.Error()
+	return &TransientError{WrappedError: &WrappedError{Message: &message}, Err: err}
+}
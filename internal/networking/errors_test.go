@@ -0,0 +1,85 @@
+package networking
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewApiError_ProjectNotFound(t *testing.T) {
+	err := newApiError(apiErrorResponse(http.StatusBadRequest), &WrappedError{
+		Message: stringPtr("VS800075: The project with id 'vstfs:///Classification/TeamProject/00000000-0000-0000-0000-000000000000' does not exist, or you do not have permission to access it."),
+	})
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected a not found error, got %T: %v", err, err)
+	}
+	if errors.Is(err, ErrValidation) {
+		t.Fatal("the missing project was reported as a validation error")
+	}
+	var notFoundError *NotFoundError
+	if !errors.As(err, &notFoundError) {
+		t.Fatalf("expected a *NotFoundError, got %T", err)
+	}
+}
+
+func TestNewApiError_MissingScope(t *testing.T) {
+	err := newApiError(apiErrorResponse(http.StatusUnauthorized), &WrappedError{
+		Message: stringPtr("The requested operation requires the scope 'vso.project_manage', which the personal access token lacks."),
+	})
+
+	if !errors.Is(err, ErrForbidden) || errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected a forbidden error, got %T: %v", err, err)
+	}
+	var forbiddenError *ForbiddenError
+	if !errors.As(err, &forbiddenError) {
+		t.Fatalf("expected a *ForbiddenError, got %T", err)
+	}
+	if forbiddenError.MissingScope != "vso.project_manage" {
+		t.Fatalf("expected the missing scope 'vso.project_manage', got '%s'", forbiddenError.MissingScope)
+	}
+	if !strings.Contains(err.Error(), "missing the scope 'vso.project_manage'") {
+		t.Fatalf("the message does not report the missing scope: %s", err.Error())
+	}
+}
+
+func TestNewApiError_StatusCodes(t *testing.T) {
+	cases := map[int]error{
+		http.StatusNonAuthoritativeInfo: ErrUnauthorized,
+		http.StatusBadRequest:           ErrValidation,
+		http.StatusUnauthorized:         ErrUnauthorized,
+		http.StatusForbidden:            ErrForbidden,
+		http.StatusNotFound:             ErrNotFound,
+		http.StatusConflict:             ErrConflict,
+		http.StatusTooManyRequests:      ErrThrottled,
+		http.StatusInternalServerError:  ErrTransient,
+	}
+	for statusCode, expected := range cases {
+		if err := newApiError(apiErrorResponse(statusCode), &WrappedError{Message: stringPtr("failure")}); !errors.Is(err, expected) {
+			t.Errorf("status code %d: expected %v, got %T: %v", statusCode, expected, err, err)
+		}
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	errorCode := 400
+	err := newApiError(apiErrorResponse(http.StatusBadRequest), &WrappedError{
+		ErrorCode: &errorCode,
+		Message:   stringPtr("The project name is invalid."),
+		TypeKey:   stringPtr("InvalidProjectNameException"),
+	})
+
+	expected := "The project name is invalid. (InvalidProjectNameException, error code 400)"
+	if err.Error() != expected {
+		t.Fatalf("expected '%s', got '%s'", expected, err.Error())
+	}
+}
+
+func apiErrorResponse(statusCode int) *http.Response {
+	return &http.Response{Header: http.Header{}, StatusCode: statusCode}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,115 @@
+package networking
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+const (
+	DefaultMaxConcurrentRequests = 10
+)
+
+// Limiters are shared by every client of an organization, including the ones of different provider configurations,
+// since Azure DevOps throttles the requests of an organization as a whole.
+var (
+	limiters      = map[string]*ConcurrencyLimiter{}
+	limitersMutex sync.Mutex
+)
+
+// ConcurrencyLimiter bounds the number of requests sent at the same time to an organization. Terraform reads and
+// applies resources in parallel, which would otherwise get the provider throttled on large configurations.
+type ConcurrencyLimiter struct {
+	active  int
+	limit   int
+	mutex   sync.Mutex
+	waiters []chan struct{}
+}
+
+func NewConcurrencyLimiter(maxConcurrentRequests int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{limit: maxConcurrentRequests}
+}
+
+// GetConcurrencyLimiter returns the limiter of an organization, created on first use. The limit of an existing limiter
+// is updated, the last configured limit applies to all the clients of the organization.
+func GetConcurrencyLimiter(organizationUrl string, maxConcurrentRequests int) *ConcurrencyLimiter {
+	key := strings.ToLower(strings.TrimSuffix(organizationUrl, "/"))
+
+	limitersMutex.Lock()
+	defer limitersMutex.Unlock()
+
+	limiter, ok := limiters[key]
+	if !ok {
+		limiter = NewConcurrencyLimiter(maxConcurrentRequests)
+		limiters[key] = limiter
+		return limiter
+	}
+
+	limiter.setLimit(maxConcurrentRequests)
+	return limiter
+}
+
+// Private Methods
+
+// acquire waits for a free slot, or until the context is done. A limit lower than 1 disables the limiter.
+func (l *ConcurrencyLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	if l.limit < 1 || l.active < l.limit {
+		l.active++
+		l.mutex.Unlock()
+		return nil
+	}
+
+	waiter := make(chan struct{})
+	l.waiters = append(l.waiters, waiter)
+	l.mutex.Unlock()
+
+	select {
+	case <-waiter:
+		return nil
+	case <-ctx.Done():
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+		for i, w := range l.waiters {
+			if w == waiter {
+				l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+				return ctx.Err()
+			}
+		}
+		// The slot was handed over while the context was canceled, it goes to the next waiter
+		l.active--
+		l.wakeWaiters()
+		return ctx.Err()
+	}
+}
+
+func (l *ConcurrencyLimiter) release() {
+	if l == nil {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.active--
+	l.wakeWaiters()
+}
+
+func (l *ConcurrencyLimiter) setLimit(maxConcurrentRequests int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.limit = maxConcurrentRequests
+	l.wakeWaiters()
+}
+
+// wakeWaiters hands the free slots over to the waiters, in the order they started waiting. The mutex must be held.
+func (l *ConcurrencyLimiter) wakeWaiters() {
+	for len(l.waiters) > 0 && (l.limit < 1 || l.active < l.limit) {
+		l.active++
+		close(l.waiters[0])
+		l.waiters = l.waiters[1:]
+	}
+}
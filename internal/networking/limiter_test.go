@@ -0,0 +1,115 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestConcurrencyLimiter_Limit(t *testing.T) {
+	limiter := NewConcurrencyLimiter(2)
+	for i := 0; i < 2; i++ {
+		if err := limiter.acquire(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	acquired := acquireAsync(limiter, context.Background())
+	assertNotAcquired(t, acquired, "the third request was sent beyond the limit")
+
+	limiter.release()
+	assertAcquired(t, acquired, "the waiting request was not sent when a slot was released")
+	if limiter.active != 2 {
+		t.Fatalf("expected 2 active requests, got %d", limiter.active)
+	}
+}
+
+func TestConcurrencyLimiter_CanceledWaiter(t *testing.T) {
+	limiter := NewConcurrencyLimiter(1)
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := acquireAsync(limiter, ctx)
+	waiting := acquireAsync(limiter, context.Background())
+	assertNotAcquired(t, canceled, "the request was sent beyond the limit")
+
+	cancel()
+	select {
+	case err := <-canceled:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected the waiter to give up with its context, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the waiter did not give up when its context was canceled")
+	}
+
+	// The canceled waiter does not hold a slot, the next waiter gets the released one
+	limiter.release()
+	assertAcquired(t, waiting, "the next waiter was not woken when the slot was released")
+	if limiter.active != 1 || len(limiter.waiters) != 0 {
+		t.Fatalf("expected 1 active request and no waiter, got %d and %d", limiter.active, len(limiter.waiters))
+	}
+}
+
+func TestConcurrencyLimiter_SetLimit(t *testing.T) {
+	limiter := NewConcurrencyLimiter(1)
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	first := acquireAsync(limiter, context.Background())
+	second := acquireAsync(limiter, context.Background())
+	assertNotAcquired(t, first, "the request was sent beyond the limit")
+
+	limiter.setLimit(3)
+	assertAcquired(t, first, "the waiters were not woken when the limit was raised")
+	assertAcquired(t, second, "the waiters were not woken when the limit was raised")
+
+	// A limit lower than 1 disables the limiter
+	limiter.setLimit(0)
+	assertAcquired(t, acquireAsync(limiter, context.Background()), "the disabled limiter blocked a request")
+}
+
+func TestGetConcurrencyLimiter_SharedByOrganization(t *testing.T) {
+	limiter := GetConcurrencyLimiter("https://dev.azure.com/limiter-test/", 2)
+	if other := GetConcurrencyLimiter("https://dev.azure.com/Limiter-Test", 5); other != limiter {
+		t.Fatal("expected the organization to share its limiter")
+	}
+	if limiter.limit != 5 {
+		t.Fatalf("expected the last configured limit 5, got %d", limiter.limit)
+	}
+	if other := GetConcurrencyLimiter("https://dev.azure.com/other-limiter-test", 5); other == limiter {
+		t.Fatal("expected another organization to have its own limiter")
+	}
+}
+
+func acquireAsync(limiter *ConcurrencyLimiter, ctx context.Context) chan error {
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- limiter.acquire(ctx)
+	}()
+	return acquired
+}
+
+func assertAcquired(t *testing.T, acquired chan error, message string) {
+	t.Helper()
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal(message)
+	}
+}
+
+func assertNotAcquired(t *testing.T, acquired chan error, message string) {
+	t.Helper()
+	select {
+	case <-acquired:
+		t.Fatal(message)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
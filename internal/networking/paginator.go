@@ -0,0 +1,150 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	PagingModeContinuationToken PagingMode = iota
+	PagingModeTopSkip
+
+	defaultPageSize        = 100
+	queryContinuationToken = "continuationToken"
+	querySkip              = "$skip"
+	queryTop               = "$top"
+)
+
+// PagingMode is the way a list call of the REST API is paged.
+type PagingMode int
+
+type PaginatorConfig struct {
+	ApiVersion   string
+	Mode         PagingMode // PagingModeContinuationToken by default
+	PageSize     int        // Value of $top, 0 for the page size of the service with continuation tokens and 100 with $top/$skip
+	PathSegments []string
+	QueryParams  url.Values
+}
+
+// Paginator requests the pages of a list call one by one. With continuation tokens, the token of the next page is
+// read from the X-MS-ContinuationToken header or from the continuationToken field of the body. With $top/$skip, the
+// last page is the first one having less items than the page size.
+type Paginator[T any] struct {
+	client            *RestClient
+	config            PaginatorConfig
+	continuationToken string
+	done              bool
+	skip              int
+}
+
+type page[T any] struct {
+	ContinuationToken *string `json:"continuationToken"`
+	Count             *int    `json:"count"`
+	Value             *[]T    `json:"value"`
+}
+
+func NewPaginator[T any](c *RestClient, config PaginatorConfig) *Paginator[T] {
+	if config.Mode == PagingModeTopSkip && config.PageSize <= 0 {
+		config.PageSize = defaultPageSize
+	}
+	return &Paginator[T]{
+		client: c,
+		config: config,
+	}
+}
+
+// ForEach calls fn with every item of every page until fn returns false, the following pages being never requested.
+func ForEach[T any](c *RestClient, ctx context.Context, config PaginatorConfig, fn func(item *T) bool) error {
+	paginator := NewPaginator[T](c, config)
+	for paginator.HasMorePages() {
+		items, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range items {
+			if !fn(&items[i]) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// GetAllPages returns the items of all the pages of a list call.
+func GetAllPages[T any](c *RestClient, ctx context.Context, config PaginatorConfig) (*[]T, error) {
+	items := []T{}
+	err := ForEach[T](c, ctx, config, func(item *T) bool {
+		items = append(items, *item)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &items, nil
+}
+
+func (p *Paginator[T]) HasMorePages() bool {
+	return !p.done
+}
+
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	result, resp, err := GetJSON[page[T]](p.client, ctx, p.config.PathSegments, p.queryParams(), p.config.ApiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if result != nil && result.Value != nil {
+		items = *result.Value
+	}
+
+	switch p.config.Mode {
+	case PagingModeTopSkip:
+		p.skip += len(items)
+		p.done = len(items) < p.config.PageSize
+	default:
+		continuationToken := resp.Header.Get(HeaderKeyContinuationToken)
+		if continuationToken == "" && result != nil && result.ContinuationToken != nil {
+			continuationToken = *result.ContinuationToken
+		}
+		if continuationToken != "" && continuationToken == p.continuationToken {
+			p.done = true
+			return nil, fmt.Errorf("the service returned the continuation token '%s' twice", continuationToken)
+		}
+		p.continuationToken = continuationToken
+		p.done = continuationToken == ""
+	}
+	return items, nil
+}
+
+// Private Methods
+
+// queryParams returns a copy of the query parameters of the list call with the paging parameters of the next page,
+// since the query parameters of a request are modified when sent.
+func (p *Paginator[T]) queryParams() url.Values {
+	queryParams := url.Values{}
+	for key, values := range p.config.QueryParams {
+		queryParams[key] = append([]string{}, values...)
+	}
+
+	if p.config.PageSize > 0 {
+		queryParams.Set(queryTop, strconv.Itoa(p.config.PageSize))
+	}
+	switch p.config.Mode {
+	case PagingModeTopSkip:
+		if p.skip > 0 {
+			queryParams.Set(querySkip, strconv.Itoa(p.skip))
+		}
+	default:
+		if p.continuationToken != "" {
+			queryParams.Set(queryContinuationToken, p.continuationToken)
+		}
+	}
+	return queryParams
+}
@@ -0,0 +1,147 @@
+package networking
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type paginatorItem struct {
+	Name string `json:"name"`
+}
+
+func TestPaginator_ContinuationTokenHeader(t *testing.T) {
+	server := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("$filter") != "all" {
+			t.Errorf("the query parameters of the list call were not sent: %s", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get(queryContinuationToken) {
+		case "":
+			w.Header().Set(HeaderKeyContinuationToken, "page2")
+			_, _ = w.Write([]byte(`{"count":2,"value":[{"name":"a"},{"name":"b"}]}`))
+		case "page2":
+			_, _ = w.Write([]byte(`{"count":1,"value":[{"name":"c"}]}`))
+		default:
+			t.Errorf("unexpected continuation token in %s", r.URL.RawQuery)
+		}
+	})
+
+	items, err := GetAllPages[paginatorItem](newPagesClient(server), context.Background(), PaginatorConfig{
+		PathSegments: []string{"_apis", "projects"},
+		QueryParams:  url.Values{"$filter": []string{"all"}},
+	})
+	assertPaginatorItems(t, items, err, "a", "b", "c")
+}
+
+func TestPaginator_ContinuationTokenBody(t *testing.T) {
+	server := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get(queryContinuationToken) {
+		case "":
+			_, _ = w.Write([]byte(`{"continuationToken":"page2","value":[{"name":"a"}]}`))
+		case "page2":
+			_, _ = w.Write([]byte(`{"continuationToken":"page3","value":[{"name":"b"}]}`))
+		case "page3":
+			_, _ = w.Write([]byte(`{"value":[{"name":"c"}]}`))
+		}
+	})
+
+	items, err := GetAllPages[paginatorItem](newPagesClient(server), context.Background(), PaginatorConfig{PathSegments: []string{"_apis", "graph", "groups"}})
+	assertPaginatorItems(t, items, err, "a", "b", "c")
+}
+
+func TestPaginator_RepeatedContinuationToken(t *testing.T) {
+	requests := 0
+	server := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(HeaderKeyContinuationToken, "same")
+		_, _ = w.Write([]byte(`{"value":[{"name":"a"}]}`))
+	})
+
+	_, err := GetAllPages[paginatorItem](newPagesClient(server), context.Background(), PaginatorConfig{PathSegments: []string{"_apis", "graph", "groups"}})
+	if err == nil || !strings.Contains(err.Error(), "continuation token 'same' twice") {
+		t.Fatalf("expected the repeated continuation token to be reported, got %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected the paging to stop on the second page, got %d requests", requests)
+	}
+}
+
+func TestPaginator_TopSkip(t *testing.T) {
+	var skips []string
+	server := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if top := r.URL.Query().Get(queryTop); top != "2" {
+			t.Errorf("expected $top=2, got '%s'", top)
+		}
+		skip := r.URL.Query().Get(querySkip)
+		skips = append(skips, skip)
+		switch skip {
+		case "":
+			_, _ = w.Write([]byte(`{"count":2,"value":[{"name":"a"},{"name":"b"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"count":2,"value":[{"name":"c"},{"name":"d"}]}`))
+		case "4":
+			_, _ = w.Write([]byte(`{"count":1,"value":[{"name":"e"}]}`))
+		default:
+			t.Errorf("the page after the short page was requested: $skip=%s", skip)
+		}
+	})
+
+	items, err := GetAllPages[paginatorItem](newPagesClient(server), context.Background(), PaginatorConfig{
+		Mode:         PagingModeTopSkip,
+		PageSize:     2,
+		PathSegments: []string{"_apis", "projects"},
+	})
+	assertPaginatorItems(t, items, err, "a", "b", "c", "d", "e")
+	if !reflect.DeepEqual(skips, []string{"", "2", "4"}) {
+		t.Fatalf("expected the $skip of the pages to be [\"\" \"2\" \"4\"], got %q", skips)
+	}
+}
+
+func TestPaginator_ForEachStops(t *testing.T) {
+	requests := 0
+	server := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(HeaderKeyContinuationToken, "page"+r.URL.Query().Get(queryContinuationToken))
+		_, _ = w.Write([]byte(`{"value":[{"name":"a"},{"name":"b"}]}`))
+	})
+
+	var names []string
+	err := ForEach[paginatorItem](newPagesClient(server), context.Background(), PaginatorConfig{PathSegments: []string{"_apis", "projects"}}, func(item *paginatorItem) bool {
+		names = append(names, item.Name)
+		return false
+	})
+	if err != nil || requests != 1 || !reflect.DeepEqual(names, []string{"a"}) {
+		t.Fatalf("expected a single page and item, got %d requests and %v: %v", requests, names, err)
+	}
+}
+
+func assertPaginatorItems(t *testing.T, items *[]paginatorItem, err error, expected ...string) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, item := range *items {
+		names = append(names, item.Name)
+	}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected the items %v, got %v", expected, names)
+	}
+}
+
+func newPagesClient(server *httptest.Server) *RestClient {
+	return NewRestClient(server.Client(), server.URL, NewPersonalAccessTokenProvider("test"), "test", nil)
+}
+
+func newPagesServer(t *testing.T, handle http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerKeyContentType, mediaTypeApplicationJson)
+		handle(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
@@ -0,0 +1,137 @@
+package networking
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	mediaTypeFormUrlEncoded = "application/x-www-form-urlencoded"
+	redactedValue           = "[REDACTED]"
+)
+
+// Headers and body fields which are never written to a cassette or to the logs, matched case-insensitively.
+var (
+	sensitiveHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization", "Set-Cookie"}
+	sensitiveFields  = []string{
+		"access_token", "accesstoken", "apitoken", "client_assertion", "client_secret", "id_token", "kubeconfig",
+		"nugetkey", "password", "refresh_token", "secret", "serviceprincipalkey",
+	}
+)
+
+// Private Methods
+
+func isSensitiveField(name string) bool {
+	for _, field := range sensitiveFields {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns a body to log. On top of the sensitive fields, every authorization parameter of the service
+// endpoints is masked, since each type of service endpoint names its secrets differently.
+func redactBody(body []byte, contentType string) string {
+	return scrubBodyWith(body, contentType, func(value any) any {
+		return redactAuthorizationParameters(scrubJSON(value))
+	})
+}
+
+func redactAuthorizationParameters(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if authorization, ok := field.(map[string]any); ok && strings.EqualFold(key, "authorization") {
+				for name, parameters := range authorization {
+					if p, ok := parameters.(map[string]any); ok && strings.EqualFold(name, "parameters") {
+						for parameter := range p {
+							p[parameter] = redactedValue
+						}
+					}
+				}
+			}
+			v[key] = redactAuthorizationParameters(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactAuthorizationParameters(item)
+		}
+	}
+	return value
+}
+
+func scrubBody(body []byte, contentType string) string {
+	return scrubBodyWith(body, contentType, scrubJSON)
+}
+
+func scrubBodyWith(body []byte, contentType string, scrub func(value any) any) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if strings.HasPrefix(contentType, mediaTypeFormUrlEncoded) {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			return scrubValues(values).Encode()
+		}
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	scrubbed, err := json.Marshal(scrub(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+func scrubHeaders(headers http.Header) http.Header {
+	scrubbed := headers.Clone()
+	for _, header := range sensitiveHeaders {
+		if scrubbed.Get(header) != "" {
+			scrubbed.Set(header, redactedValue)
+		}
+	}
+	return scrubbed
+}
+
+// scrubJSON replaces the values of the sensitive fields of a JSON document, including the authorization parameters
+// of service endpoints which are stored as a map of strings.
+func scrubJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if _, isString := field.(string); isString && isSensitiveField(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = scrubJSON(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = scrubJSON(item)
+		}
+	}
+	return value
+}
+
+func scrubUrl(u *url.URL) string {
+	scrubbed := *u
+	scrubbed.User = nil
+	scrubbed.RawQuery = scrubValues(u.Query()).Encode()
+	return scrubbed.String()
+}
+
+func scrubValues(values url.Values) url.Values {
+	for key := range values {
+		if isSensitiveField(key) {
+			values[key] = []string{redactedValue}
+		}
+	}
+	return values
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
 	"io"
 	"net/http"
@@ -11,24 +13,47 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"time"
 )
 
 type RestClient struct {
-	authorization   string
+	apiVersions     *ApiVersionNegotiator
 	baseUrl         string
+	httpClient      *http.Client
+	limiter         *ConcurrencyLimiter
 	providerVersion string
+	retryPolicy     *RetryPolicy
+	subsystem       string
+	tokenProvider   TokenProvider
 }
 
 type NoJSON string
 
-func NewRestClient(baseUrl string, authorization string, providerVersion string) *RestClient {
+func NewRestClient(httpClient *http.Client, baseUrl string, tokenProvider TokenProvider, providerVersion string, retryPolicy *RetryPolicy) *RestClient {
 	return &RestClient{
-		authorization:   authorization,
 		baseUrl:         baseUrl,
+		httpClient:      httpClient,
 		providerVersion: providerVersion,
+		retryPolicy:     retryPolicy,
+		subsystem:       logger.SubsystemNetworking,
+		tokenProvider:   tokenProvider,
 	}
 }
 
+// WithLimiter returns a copy of the client whose requests wait for a slot of a limiter before being sent.
+func (c *RestClient) WithLimiter(limiter *ConcurrencyLimiter) *RestClient {
+	client := *c
+	client.limiter = limiter
+	return &client
+}
+
+// WithSubsystem returns a copy of the client whose requests are logged by the logger of a subsystem.
+func (c *RestClient) WithSubsystem(subsystem string) *RestClient {
+	client := *c
+	client.subsystem = subsystem
+	return &client
+}
+
 func GetJSON[T any](c *RestClient, ctx context.Context, pathSegments []string, queryParams url.Values, apiVersion string) (*T, *http.Response, error) {
 	return sendRequestJSON[T](c, ctx, http.MethodGet, pathSegments, queryParams, nil, nil, apiVersion)
 }
@@ -59,10 +84,9 @@ func PutJSON[T any](c *RestClient, ctx context.Context, pathSegments []string, q
 
 func (c *RestClient) buildRequestHeaders() map[string]string {
 	return map[string]string{
-		headerKeyAccept:        mediaTypeApplicationJson,
-		headerKeyAuthorization: c.authorization,
-		headerKeyContentType:   mediaTypeApplicationJson,
-		headerKeyUserAgent:     "go/" + runtime.Version() + " (" + runtime.GOOS + " " + runtime.GOARCH + ") terraform-provider-azuredevops/" + c.providerVersion,
+		headerKeyAccept:      mediaTypeApplicationJson,
+		headerKeyContentType: mediaTypeApplicationJson,
+		headerKeyUserAgent:   "go/" + runtime.Version() + " (" + runtime.GOOS + " " + runtime.GOARCH + ") terraform-provider-azuredevops/" + c.providerVersion,
 	}
 }
 
@@ -76,16 +100,44 @@ func (c *RestClient) generateUrl(pathSegments []string, queryParams url.Values,
 	if queryParams == nil {
 		queryParams = make(url.Values)
 	}
-	queryParams.Add("api-version", apiVersion)
-	builder.WriteString("?")
-	builder.WriteString(queryParams.Encode())
+	if apiVersion != "" {
+		queryParams.Add("api-version", apiVersion)
+	}
+	if len(queryParams) > 0 {
+		builder.WriteString("?")
+		builder.WriteString(queryParams.Encode())
+	}
 	return builder.String()
 }
 
+// isSignInPage returns whether Azure DevOps answered with the HTML of its sign-in page, which it does with a 203
+// status code instead of a 401 when the credentials of a request are rejected.
+func isSignInPage(response *http.Response) bool {
+	return response.StatusCode == http.StatusNonAuthoritativeInfo && strings.HasPrefix(response.Header.Get(headerKeyContentType), mediaTypeTextHtml)
+}
+
 func isJSON[T any]() bool {
 	return reflect.TypeOf(new(T)).String() != "*networking.NoJSON"
 }
 
+// logResponse logs the status and the duration of an attempt, along with the activity identifier Azure DevOps
+// gives to the request, which is the identifier to give to the support of Azure DevOps.
+func (c *RestClient) logResponse(ctx context.Context, attempt int, duration time.Duration, resp *http.Response, err error) {
+	fields := map[string]any{
+		logFieldAttempt:  attempt + 1,
+		logFieldDuration: duration.Milliseconds(),
+	}
+	if err != nil {
+		fields[logFieldError] = err.Error()
+		logger.Debug(ctx, "Request failed", fields)
+		return
+	}
+
+	fields[logFieldActivityId] = resp.Header.Get(headerKeyActivityId)
+	fields[logFieldStatusCode] = resp.StatusCode
+	logger.Debug(ctx, "Received response", fields)
+}
+
 func (c *RestClient) parseJSON(ctx context.Context, response *http.Response, v any) error {
 	if response == nil || response.Body == nil {
 		return nil
@@ -103,37 +155,85 @@ func (c *RestClient) parseJSON(ctx context.Context, response *http.Response, v a
 		return err
 	}
 	body = c.trimByteOrderMark(body)
-	logger.Debug(ctx, string(body))
+	logger.Debug(ctx, "Received response body", map[string]any{
+		logFieldBody: redactBody(body, response.Header.Get(headerKeyContentType)),
+	})
 	return json.Unmarshal(body, &v)
 }
 
 func (c *RestClient) sendRequest(ctx context.Context, httpMethod string, pathSegments []string, queryParams url.Values, headers map[string]string, body any, apiVersion string) (*http.Response, error) {
 	endpointUrl := c.generateUrl(pathSegments, queryParams, apiVersion)
-	logger.Info(ctx, httpMethod+" "+endpointUrl)
-	var jsonReader io.Reader
+	logger.Info(ctx, "Sending request", map[string]any{
+		logFieldMethod: httpMethod,
+		logFieldUrl:    endpointUrl,
+	})
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
 
-		logger.Debug(ctx, string(jsonBody))
-		jsonReader = bytes.NewReader(jsonBody)
-	}
-	req, err := http.NewRequest(httpMethod, endpointUrl, jsonReader)
-	if err != nil {
-		return nil, err
+		logger.Debug(ctx, "Sending request body", map[string]any{
+			logFieldBody: redactBody(jsonBody, headers[headerKeyContentType]),
+		})
 	}
 
-	for k, v := range headers {
-		req.Header.Add(k, v)
-	}
+	var totalWait time.Duration
+	for attempt := 0; ; attempt++ {
+		var jsonReader io.Reader
+		if jsonBody != nil {
+			jsonReader = bytes.NewReader(jsonBody)
+		}
+		req, err := http.NewRequestWithContext(ctx, httpMethod, endpointUrl, jsonReader)
+		if err != nil {
+			return nil, err
+		}
 
-	resp, err := http.DefaultClient.Do(req)
-	if resp != nil && (resp.StatusCode < 200 || resp.StatusCode >= 300) {
-		err = c.unwrapError(resp)
+		for k, v := range headers {
+			req.Header.Add(k, v)
+		}
+
+		authorization, err := c.tokenProvider.GetAuthorization(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add(headerKeyAuthorization, authorization)
+
+		// The slot is only held while waiting for the response, not while sleeping before a retry
+		if err = c.limiter.acquire(ctx); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		c.limiter.release()
+		c.logResponse(ctx, attempt, time.Since(start), resp, err)
+		delay, retry := c.retryPolicy.retryDelay(ctx, httpMethod, endpointUrl, attempt, resp, err)
+		if retry && totalWait+delay > c.retryPolicy.MaxWait {
+			logger.Warn(ctx, fmt.Sprintf("%s %s: giving up after waiting %s, the maximum retry wait time is %s", httpMethod, endpointUrl, totalWait, c.retryPolicy.MaxWait))
+			retry = false
+		}
+		if !retry {
+			if err != nil {
+				return resp, newTransientError(err)
+			}
+			if resp.StatusCode < 200 || resp.StatusCode >= 300 || isSignInPage(resp) {
+				err = c.unwrapError(resp)
+			}
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if err = sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		totalWait += delay
 	}
-	return resp, err
 }
 
 func sendRequestJSON[T any](c *RestClient, ctx context.Context, httpMethod string, pathSegments []string, queryParams url.Values, headers *map[string]string, body any, apiVersion string) (*T, *http.Response, error) {
@@ -143,6 +243,10 @@ func sendRequestJSON[T any](c *RestClient, ctx context.Context, httpMethod strin
 	} else {
 		requestHeaders = c.buildRequestHeaders()
 	}
+	// Every message logged for the request, including its retries, carries the same identifier
+	ctx = logger.NewSubsystem(ctx, c.subsystem)
+	ctx = logger.SetField(ctx, logFieldRequestId, uuid.NewString())
+	apiVersion = c.apiVersions.resolve(ctx, pathSegments, apiVersion)
 	resp, err := c.sendRequest(ctx, httpMethod, pathSegments, queryParams, requestHeaders, body, apiVersion)
 	if err != nil {
 		return nil, nil, err
@@ -151,6 +255,9 @@ func sendRequestJSON[T any](c *RestClient, ctx context.Context, httpMethod strin
 	var result *T
 	if isJSON[T]() {
 		err = c.parseJSON(ctx, resp, &result)
+	} else if resp.Body != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
 	}
 	return result, resp, err
 }
@@ -159,24 +266,40 @@ func (c *RestClient) trimByteOrderMark(body []byte) []byte {
 	return bytes.TrimPrefix(body, []byte("\xef\xbb\xbf"))
 }
 
-func (c *RestClient) unwrapError(response *http.Response) (err error) {
+// unwrapError reads the error returned by Azure DevOps in the body of a failed response, and types it after the status
+// code of the response.
+func (c *RestClient) unwrapError(response *http.Response) error {
+	wrappedError, err := c.readWrappedError(response)
+	if err != nil {
+		return err
+	}
+
+	wrappedError.StatusCode = &response.StatusCode
+	return newApiError(response, wrappedError)
+}
+
+func (c *RestClient) readWrappedError(response *http.Response) (wrappedError *WrappedError, err error) {
+	if isSignInPage(response) {
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+		message := "Azure DevOps redirected the request to its sign-in page, the credentials are invalid or expired"
+		return &WrappedError{Message: &message}, nil
+	}
+
 	if response.ContentLength == 0 {
 		message := "Request returned status: " + response.Status
-		return &WrappedError{
-			Message:    &message,
-			StatusCode: &response.StatusCode,
-		}
+		return &WrappedError{Message: &message}, nil
 	}
 
 	defer func() {
-		if closeError := response.Body.Close(); closeError != nil {
+		if closeError := response.Body.Close(); closeError != nil && err == nil {
 			err = closeError
 		}
 	}()
 
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	body = c.trimByteOrderMark(body)
@@ -184,27 +307,23 @@ func (c *RestClient) unwrapError(response *http.Response) (err error) {
 	contentType, ok := response.Header[headerKeyContentType]
 	if ok && len(contentType) > 0 && strings.Index(contentType[0], mediaTypeTextPlain) >= 0 {
 		message := string(body)
-		statusCode := response.StatusCode
-		return WrappedError{Message: &message, StatusCode: &statusCode}
+		return &WrappedError{Message: &message}, nil
 	}
 
-	var wrappedError WrappedError
-	err = json.Unmarshal(body, &wrappedError)
-	wrappedError.StatusCode = &response.StatusCode
-	if err != nil {
-		return err
+	wrappedError = &WrappedError{}
+	if err = json.Unmarshal(body, wrappedError); err != nil {
+		// Gateways and load balancers answer with HTML pages, only the status is meaningful
+		message := "Request returned status: " + response.Status
+		return &WrappedError{Message: &message}, nil
 	}
 
 	if wrappedError.Message == nil {
 		var wrappedImproperError WrappedImproperError
 		err = json.Unmarshal(body, &wrappedImproperError)
 		if err == nil && wrappedImproperError.Value != nil && wrappedImproperError.Value.Message != nil {
-			return &WrappedError{
-				Message:    wrappedImproperError.Value.Message,
-				StatusCode: &response.StatusCode,
-			}
+			return &WrappedError{Message: wrappedImproperError.Value.Message}, nil
 		}
 	}
 
-	return wrappedError
+	return wrappedError, nil
 }
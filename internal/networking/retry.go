@@ -0,0 +1,165 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 5
+	DefaultMaxRetryWait = 5 * time.Minute
+
+	defaultMinBackoff = 1 * time.Second
+	defaultMaxBackoff = 1 * time.Minute
+)
+
+type RetryPolicy struct {
+	MaxRetries int           // Maximum number of retries for a single request
+	MaxWait    time.Duration // Maximum cumulated wait time across all retries of a single request
+	MinBackoff time.Duration // Base delay of the exponential backoff
+	MaxBackoff time.Duration // Upper bound of a single backoff delay
+}
+
+func NewRetryPolicy(maxRetries int, maxWait time.Duration) *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: maxRetries,
+		MaxWait:    maxWait,
+		MinBackoff: defaultMinBackoff,
+		MaxBackoff: defaultMaxBackoff,
+	}
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return NewRetryPolicy(DefaultMaxRetries, DefaultMaxRetryWait)
+}
+
+// Private Methods
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.MinBackoff) * math.Pow(2, float64(attempt))
+	if delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	// Full jitter, see https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// retryDelay returns the delay to wait before the next attempt and whether the request should be retried at all.
+func (p *RetryPolicy) retryDelay(ctx context.Context, httpMethod string, endpointUrl string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	if err != nil {
+		if !isIdempotent(httpMethod) || !isTransientError(err) {
+			return 0, false
+		}
+
+		logger.Warn(ctx, fmt.Sprintf("%s %s failed with a transient error, retrying (%d/%d): %s", httpMethod, endpointUrl, attempt+1, p.MaxRetries, err.Error()))
+		return p.backoff(attempt), true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// A throttled request has not been processed by the server, it is safe to retry it whatever the verb.
+	case http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusGatewayTimeout:
+		if !isIdempotent(httpMethod) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	delay, ok := throttlingDelay(resp)
+	if !ok {
+		delay = p.backoff(attempt)
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		logger.Warn(ctx, fmt.Sprintf("%s %s failed with a server error (status: %d), retrying in %s (%d/%d)", httpMethod, endpointUrl, resp.StatusCode, delay, attempt+1, p.MaxRetries))
+		return delay, true
+	}
+
+	logger.Warn(ctx, fmt.Sprintf("%s %s was throttled (resource: '%s', delay: '%s'), retrying in %s (%d/%d)",
+		httpMethod,
+		endpointUrl,
+		resp.Header.Get(headerKeyRateLimitResource),
+		resp.Header.Get(headerKeyRateLimitDelay),
+		delay,
+		attempt+1,
+		p.MaxRetries))
+	return delay, true
+}
+
+func isIdempotent(httpMethod string) bool {
+	switch httpMethod {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut:
+		return true
+	default:
+		return false
+	}
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttlingDelay reads the delay requested by Azure DevOps from the Retry-After or X-RateLimit-Reset headers.
+// See https://learn.microsoft.com/en-us/azure/devops/integrate/concepts/rate-limits#api-client-experience
+func throttlingDelay(resp *http.Response) (time.Duration, bool) {
+	if retryAfter := resp.Header.Get(headerKeyRetryAfter); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return maxDuration(time.Until(date), 0), true
+		}
+	}
+
+	if reset := resp.Header.Get(headerKeyRateLimitReset); reset != "" {
+		if epoch, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			return maxDuration(time.Until(time.Unix(epoch, 0)), 0), true
+		}
+	}
+
+	return 0, false
+}
+
+func maxDuration(a time.Duration, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,121 @@
+package networking
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottlingDelay(t *testing.T) {
+	cases := []struct {
+		name     string
+		headers  map[string]string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "Retry-After seconds", headers: map[string]string{headerKeyRetryAfter: "7"}, expected: 7 * time.Second, ok: true},
+		{name: "Retry-After past date", headers: map[string]string{headerKeyRetryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)}, expected: 0, ok: true},
+		{name: "X-RateLimit-Reset past epoch", headers: map[string]string{headerKeyRateLimitReset: strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)}, expected: 0, ok: true},
+		{name: "Retry-After before X-RateLimit-Reset", headers: map[string]string{headerKeyRetryAfter: "3", headerKeyRateLimitReset: strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)}, expected: 3 * time.Second, ok: true},
+		{name: "invalid Retry-After", headers: map[string]string{headerKeyRetryAfter: "soon"}, ok: false},
+		{name: "negative Retry-After", headers: map[string]string{headerKeyRetryAfter: "-1"}, ok: false},
+		{name: "no header", headers: map[string]string{}, ok: false},
+	}
+	for _, c := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		for key, value := range c.headers {
+			resp.Header.Set(key, value)
+		}
+		delay, ok := throttlingDelay(resp)
+		if ok != c.ok || delay != c.expected {
+			t.Errorf("%s: expected (%s, %t), got (%s, %t)", c.name, c.expected, c.ok, delay, ok)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set(headerKeyRateLimitReset, strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+	if delay, ok := throttlingDelay(resp); !ok || delay <= 50*time.Second || delay > time.Minute {
+		t.Errorf("X-RateLimit-Reset future epoch: expected about 1m, got (%s, %t)", delay, ok)
+	}
+}
+
+func TestRetryPolicy_BackoffCap(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+	for attempt := 0; attempt < 20; attempt++ {
+		limit := time.Duration(1<<attempt) * time.Second
+		if limit > policy.MaxBackoff || limit <= 0 {
+			limit = policy.MaxBackoff
+		}
+		if delay := policy.backoff(attempt); delay < 0 || delay > limit {
+			t.Fatalf("attempt %d: expected a delay between 0 and %s, got %s", attempt, limit, delay)
+		}
+	}
+}
+
+func TestRestClient_Retry(t *testing.T) {
+	cases := []struct {
+		name             string
+		method           string
+		statusCodes      []int
+		expectedRequests int32
+		expectedError    bool
+	}{
+		{name: "GET retried on 503", method: http.MethodGet, statusCodes: []int{http.StatusServiceUnavailable, http.StatusOK}, expectedRequests: 2},
+		{name: "PUT retried on 502 and 504", method: http.MethodPut, statusCodes: []int{http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusOK}, expectedRequests: 3},
+		{name: "POST retried on 429", method: http.MethodPost, statusCodes: []int{http.StatusTooManyRequests, http.StatusOK}, expectedRequests: 2},
+		{name: "POST not retried on 503", method: http.MethodPost, statusCodes: []int{http.StatusServiceUnavailable, http.StatusOK}, expectedRequests: 1, expectedError: true},
+		{name: "PATCH not retried on 502", method: http.MethodPatch, statusCodes: []int{http.StatusBadGateway, http.StatusOK}, expectedRequests: 1, expectedError: true},
+		{name: "GET not retried on 500", method: http.MethodGet, statusCodes: []int{http.StatusInternalServerError, http.StatusOK}, expectedRequests: 1, expectedError: true},
+		{name: "GET given up after the maximum retries", method: http.MethodGet, statusCodes: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK}, expectedRequests: 3, expectedError: true},
+	}
+	for _, c := range cases {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != c.method {
+				t.Errorf("%s: unexpected method %s", c.name, r.Method)
+			}
+			statusCode := c.statusCodes[atomic.AddInt32(&requests, 1)-1]
+			if statusCode == http.StatusTooManyRequests {
+				w.Header().Set(headerKeyRetryAfter, "0")
+			}
+			w.WriteHeader(statusCode)
+		}))
+
+		policy := &RetryPolicy{MaxRetries: 2, MaxWait: time.Second, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+		client := NewRestClient(server.Client(), server.URL, NewPersonalAccessTokenProvider("test"), "test", policy)
+		_, err := client.sendRequest(context.Background(), c.method, []string{"_apis", "projects"}, nil, client.buildRequestHeaders(), nil, "")
+		server.Close()
+
+		if (err != nil) != c.expectedError {
+			t.Errorf("%s: expected an error %t, got %v", c.name, c.expectedError, err)
+		}
+		if requests != c.expectedRequests {
+			t.Errorf("%s: expected %d requests, got %d", c.name, c.expectedRequests, requests)
+		}
+	}
+}
+
+func TestRestClient_RetryMaxWait(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set(headerKeyRetryAfter, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	policy := &RetryPolicy{MaxRetries: 5, MaxWait: time.Second, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	client := NewRestClient(server.Client(), server.URL, NewPersonalAccessTokenProvider("test"), "test", policy)
+	start := time.Now()
+	_, err := client.sendRequest(context.Background(), http.MethodGet, []string{"_apis", "projects"}, nil, client.buildRequestHeaders(), nil, "")
+	if err == nil {
+		t.Fatal("expected the throttled request to fail")
+	}
+	if requests != 1 || time.Since(start) > 5*time.Second {
+		t.Fatalf("expected a single request without waiting beyond the maximum wait time, got %d requests in %s", requests, time.Since(start))
+	}
+}
@@ -0,0 +1,104 @@
+package networking
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	DefaultMaxConnectionsPerHost = 20
+	DefaultMaxIdleConnections    = 100
+	DefaultRequestTimeout        = 2 * time.Minute
+)
+
+type HttpClientConfig struct {
+	CaCertificates        []byte       // Additional PEM encoded certificates trusted on top of the system ones
+	CassetteMode          CassetteMode // Records the interactions to CassettePath, or replays them from it
+	CassettePath          string
+	ClientCertificate     []byte // PEM encoded certificate presented for mutual TLS
+	ClientKey             []byte // PEM encoded private key of ClientCertificate
+	InsecureSkipVerify    bool
+	MaxConnectionsPerHost int
+	MaxIdleConnections    int
+	ProxyUrl              string // Defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+	RequestTimeout        time.Duration
+}
+
+func DefaultHttpClientConfig() *HttpClientConfig {
+	return &HttpClientConfig{
+		MaxConnectionsPerHost: DefaultMaxConnectionsPerHost,
+		MaxIdleConnections:    DefaultMaxIdleConnections,
+		RequestTimeout:        DefaultRequestTimeout,
+	}
+}
+
+// NewHttpClient builds the http.Client shared by every REST client and token provider of the provider.
+func NewHttpClient(config *HttpClientConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if len(config.CaCertificates) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(config.CaCertificates) {
+			return nil, errors.New("no valid PEM encoded certificate found in the CA certificates")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if len(config.ClientCertificate) > 0 || len(config.ClientKey) > 0 {
+		certificate, err := tls.X509KeyPair(config.ClientCertificate, config.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if config.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(config.ProxyUrl)
+		if err != nil {
+			return nil, err
+		}
+		proxy = http.ProxyURL(proxyUrl)
+	}
+
+	transport := &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+		IdleConnTimeout:       90 * time.Second,
+		MaxConnsPerHost:       config.MaxConnectionsPerHost,
+		MaxIdleConns:          config.MaxIdleConnections,
+		MaxIdleConnsPerHost:   config.MaxConnectionsPerHost,
+		Proxy:                 proxy,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   10 * time.Second,
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if config.CassetteMode != "" {
+		cassetteTransport, err := NewCassetteTransport(config.CassettePath, config.CassetteMode, transport)
+		if err != nil {
+			return nil, err
+		}
+		roundTripper = cassetteTransport
+	}
+
+	return &http.Client{
+		Timeout:   config.RequestTimeout,
+		Transport: roundTripper,
+	}, nil
+}
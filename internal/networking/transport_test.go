@@ -0,0 +1,142 @@
+package networking
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewHttpClient_CaCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	caCertificates := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	config := DefaultHttpClientConfig()
+	client, err := NewHttpClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unknownAuthorityError x509.UnknownAuthorityError
+	if _, err = client.Get(server.URL); !errors.As(err, &unknownAuthorityError) {
+		t.Fatalf("expected the certificate of the server to be untrusted, got %v", err)
+	}
+
+	config.CaCertificates = caCertificates
+	client, err = NewHttpClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the certificate of the server to be trusted, got %v", err)
+	}
+	_ = resp.Body.Close()
+
+	config.CaCertificates = []byte("not a certificate")
+	if _, err = NewHttpClient(config); err == nil || !strings.Contains(err.Error(), "no valid PEM encoded certificate") {
+		t.Fatalf("expected the invalid CA certificates to be rejected, got %v", err)
+	}
+}
+
+func TestNewHttpClient_ClientCertificate(t *testing.T) {
+	certificate, key, cert := newTestCertificate(t, false)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || !r.TLS.PeerCertificates[0].Equal(cert) {
+			t.Error("the client certificate was not presented")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	config := DefaultHttpClientConfig()
+	config.CaCertificates = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	client, err := NewHttpClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Get(server.URL); err == nil {
+		t.Fatal("expected the request without client certificate to be rejected")
+	}
+
+	config.ClientCertificate = certificate
+	config.ClientKey = key
+	client, err = NewHttpClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the request with the client certificate to succeed, got %v", err)
+	}
+	_ = resp.Body.Close()
+
+	_, otherKey, _ := newTestCertificate(t, true)
+	config.ClientKey = otherKey
+	if _, err = NewHttpClient(config); err == nil {
+		t.Fatal("expected the client certificate with the private key of another certificate to be rejected")
+	}
+	config.ClientCertificate = nil
+	config.ClientKey = key
+	if _, err = NewHttpClient(config); err == nil {
+		t.Fatal("expected the private key without client certificate to be rejected")
+	}
+}
+
+func TestNewHttpClient_ProxyUrl(t *testing.T) {
+	config := DefaultHttpClientConfig()
+	config.ProxyUrl = "http://proxy.contoso.com:8080"
+	client, err := NewHttpClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://dev.azure.com/contoso", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyUrl, err := client.Transport.(*http.Transport).Proxy(req)
+	if err != nil || proxyUrl == nil || proxyUrl.String() != config.ProxyUrl {
+		t.Fatalf("expected the proxy '%s', got '%v': %v", config.ProxyUrl, proxyUrl, err)
+	}
+
+	config.ProxyUrl = "://proxy"
+	if _, err = NewHttpClient(config); err == nil {
+		t.Fatal("expected the invalid proxy url to be rejected")
+	}
+}
+
+func TestNewHttpClient_RequestTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(done) })
+
+	config := DefaultHttpClientConfig()
+	config.RequestTimeout = 50 * time.Millisecond
+	client, err := NewHttpClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var netErr net.Error
+	if _, err = client.Get(server.URL); !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("expected the request to time out, got %v", err)
+	}
+}
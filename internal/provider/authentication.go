@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	authMethodClientCertificate   = "client_certificate"
+	authMethodClientSecret        = "client_secret"
+	authMethodManagedIdentity     = "msi"
+	authMethodOidc                = "oidc"
+	authMethodPersonalAccessToken = "pat"
+
+	envAuthMethod                  = "AZDO_AUTH_METHOD"
+	envAuthorityHost               = "AZDO_AUTHORITY_HOST"
+	envClientCertificate           = "AZDO_CLIENT_CERTIFICATE"
+	envClientCertificatePath       = "AZDO_CLIENT_CERTIFICATE_PATH"
+	envClientId                    = "AZDO_CLIENT_ID"
+	envClientSecret                = "AZDO_CLIENT_SECRET"
+	envMsiEndpoint                 = "AZDO_MSI_ENDPOINT"
+	envOidcRequestToken            = "AZDO_OIDC_REQUEST_TOKEN"
+	envOidcRequestUrl              = "AZDO_OIDC_REQUEST_URL"
+	envOidcServiceConnectionId     = "AZDO_OIDC_SERVICE_CONNECTION_ID"
+	envOidcToken                   = "AZDO_OIDC_TOKEN"
+	envOidcTokenFilePath           = "AZDO_OIDC_TOKEN_FILE_PATH"
+	envPersonalAccessToken         = "AZDO_PERSONAL_ACCESS_TOKEN"
+	envPersonalAccessTokenFilePath = "AZDO_PERSONAL_ACCESS_TOKEN_FILE"
+	envTenantId                    = "AZDO_TENANT_ID"
+	envUseMsi                      = "AZDO_USE_MSI"
+	envUseOidc                     = "AZDO_USE_OIDC"
+
+	envAzureAuthorityHost             = "AZURE_AUTHORITY_HOST"
+	envAzurePipelinesOidcRequestToken = "SYSTEM_ACCESSTOKEN"
+	envAzurePipelinesOidcRequestUrl   = "SYSTEM_OIDCREQUESTURI"
+	envGitHubOidcRequestToken         = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+	envGitHubOidcRequestUrl           = "ACTIONS_ID_TOKEN_REQUEST_URL"
+)
+
+var authMethods = []string{
+	authMethodClientCertificate,
+	authMethodClientSecret,
+	authMethodManagedIdentity,
+	authMethodOidc,
+	authMethodPersonalAccessToken,
+}
+
+func getTokenProvider(data *AzureDevOpsProviderModel, httpClient *http.Client) (networking.TokenProvider, error) {
+	authMethod := strings.ToLower(getString(data.AuthMethod, envAuthMethod))
+	if authMethod == "" {
+		authMethod = inferAuthMethod(data)
+	}
+
+	authorityHost := getString(data.AuthorityHost, envAuthorityHost, envAzureAuthorityHost)
+	clientId := getString(data.ClientId, envClientId)
+	tenantId := getString(data.TenantId, envTenantId)
+	switch authMethod {
+	case authMethodManagedIdentity:
+		return networking.NewManagedIdentityTokenProvider(httpClient, getString(data.MsiEndpoint, envMsiEndpoint), clientId), nil
+	case authMethodOidc:
+		if clientId == "" || tenantId == "" {
+			return nil, errors.New("'client_id' and 'tenant_id' are required to authenticate with OIDC")
+		}
+
+		var assertion networking.ClientAssertionFunc
+		if token := getString(data.OidcToken, envOidcToken); token != "" {
+			assertion = networking.OidcTokenFromValue(token)
+		} else if path := getString(data.OidcTokenFilePath, envOidcTokenFilePath); path != "" {
+			assertion = networking.OidcTokenFromFile(path)
+		} else {
+			requestUrl := getString(data.OidcRequestUrl, envOidcRequestUrl, envGitHubOidcRequestUrl, envAzurePipelinesOidcRequestUrl)
+			requestToken := getString(data.OidcRequestToken, envOidcRequestToken, envGitHubOidcRequestToken, envAzurePipelinesOidcRequestToken)
+			if requestUrl == "" || requestToken == "" {
+				return nil, errors.New("one of 'oidc_token', 'oidc_token_file_path' or 'oidc_request_url' and 'oidc_request_token' is required to authenticate with OIDC")
+			}
+			assertion = networking.OidcTokenFromRequest(httpClient, requestUrl, requestToken, getString(data.OidcServiceConnectionId, envOidcServiceConnectionId))
+		}
+		return networking.NewClientAssertionTokenProvider(httpClient, authorityHost, tenantId, clientId, assertion), nil
+	case authMethodClientCertificate:
+		if clientId == "" || tenantId == "" {
+			return nil, errors.New("'client_id' and 'tenant_id' are required to authenticate with a client certificate")
+		}
+
+		certificate := getString(data.ClientCertificate, envClientCertificate)
+		if certificate == "" {
+			certificatePath := getString(data.ClientCertificatePath, envClientCertificatePath)
+			if certificatePath == "" {
+				return nil, errors.New("one of 'client_certificate' or 'client_certificate_path' is required to authenticate with a client certificate")
+			}
+
+			content, err := os.ReadFile(certificatePath)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read client certificate file '%s': %w", certificatePath, err)
+			}
+			certificate = string(content)
+		}
+		return networking.NewClientCertificateTokenProvider(httpClient, authorityHost, tenantId, clientId, []byte(certificate))
+	case authMethodClientSecret:
+		clientSecret := getString(data.ClientSecret, envClientSecret)
+		if clientId == "" || tenantId == "" || clientSecret == "" {
+			return nil, errors.New("'client_id', 'client_secret' and 'tenant_id' are required to authenticate with a client secret")
+		}
+		return networking.NewClientSecretTokenProvider(httpClient, authorityHost, tenantId, clientId, clientSecret), nil
+	case authMethodPersonalAccessToken:
+		personalAccessToken, err := getPersonalAccessToken(data)
+		if err != nil {
+			return nil, err
+		}
+		if personalAccessToken == "" {
+			return nil, fmt.Errorf("no personal access token found, set 'personal_access_token' or 'personal_access_token_file', or the '%s' or '%s' environment variables", envPersonalAccessToken, envPersonalAccessTokenFilePath)
+		}
+		return networking.NewPersonalAccessTokenProvider(personalAccessToken), nil
+	case "":
+		return nil, fmt.Errorf("no credentials found, set one of 'personal_access_token', 'personal_access_token_file', 'client_secret', 'client_certificate', 'use_oidc' or 'use_msi', or the matching environment variables (e.g. '%s')", envPersonalAccessToken)
+	default:
+		return nil, fmt.Errorf("unknown authentication method '%s', must be one of '%s'", authMethod, strings.Join(authMethods, "', '"))
+	}
+}
+
+// Private Methods
+
+func getBool(value *bool, envs ...string) bool {
+	if value != nil {
+		return *value
+	}
+	for _, env := range envs {
+		if b, err := strconv.ParseBool(os.Getenv(env)); err == nil {
+			return b
+		}
+	}
+	return false
+}
+
+func getPersonalAccessToken(data *AzureDevOpsProviderModel) (string, error) {
+	if personalAccessToken := getString(data.PersonalAccessToken, envPersonalAccessToken); personalAccessToken != "" {
+		return personalAccessToken, nil
+	}
+
+	path := getString(data.PersonalAccessTokenFile, envPersonalAccessTokenFilePath)
+	if path == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read personal access token file '%s': %w", path, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func getString(value *string, envs ...string) string {
+	if value != nil && *value != "" {
+		return *value
+	}
+	for _, env := range envs {
+		if s := os.Getenv(env); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func inferAuthMethod(data *AzureDevOpsProviderModel) string {
+	switch {
+	case getBool(data.UseMsi, envUseMsi):
+		return authMethodManagedIdentity
+	case getBool(data.UseOidc, envUseOidc):
+		return authMethodOidc
+	case getString(data.ClientCertificate, envClientCertificate) != "" || getString(data.ClientCertificatePath, envClientCertificatePath) != "":
+		return authMethodClientCertificate
+	case getString(data.ClientSecret, envClientSecret) != "":
+		return authMethodClientSecret
+	case getString(data.PersonalAccessToken, envPersonalAccessToken) != "" || getString(data.PersonalAccessTokenFile, envPersonalAccessTokenFilePath) != "":
+		return authMethodPersonalAccessToken
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"sort"
+	"strings"
+	"time"
+)
+
+var _ datasource.DataSource = &DeletedProjectsDataSource{}
+
+func NewDeletedProjectsDataSource() datasource.DataSource {
+	return &DeletedProjectsDataSource{}
+}
+
+type DeletedProjectsDataSource struct {
+	client *core.Client
+}
+
+type DeletedProjectsDataSourceModel struct {
+	Projects []DeletedProjectsDataSourceProjectModel `tfsdk:"projects"`
+}
+
+type DeletedProjectsDataSourceProjectModel struct {
+	DeletionTime          types.String `tfsdk:"deletion_time"`
+	Description           types.String `tfsdk:"description"`
+	Id                    string       `tfsdk:"id"`
+	Name                  string       `tfsdk:"name"`
+	PermanentDeletionTime types.String `tfsdk:"permanent_deletion_time"`
+	Visibility            types.String `tfsdk:"visibility"`
+}
+
+func (d *DeletedProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deleted_projects"
+}
+
+func (d *DeletedProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to access information about the projects in the recycle bin of Azure DevOps, which can be restored until they are deleted permanently.",
+		Attributes: map[string]schema.Attribute{
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of deleted projects, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"deletion_time": schema.StringAttribute{
+							MarkdownDescription: "The time the project was deleted, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the project.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"permanent_deletion_time": schema.StringAttribute{
+							MarkdownDescription: "The time after which the project is deleted permanently and can no longer be restored, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"visibility": schema.StringAttribute{
+							MarkdownDescription: "The visibility of the project, `private` or `public`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeletedProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+}
+
+func (d *DeletedProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model DeletedProjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.GetProjects(ctx, core.ProjectStateDeleted)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve deleted projects", err.Error())
+		return
+	}
+
+	projectModels := []DeletedProjectsDataSourceProjectModel{}
+	for _, project := range *projects {
+		projectModel := DeletedProjectsDataSourceProjectModel{
+			Description: types.StringPointerValue(project.Description),
+			Id:          project.Id.String(),
+			Name:        *project.Name,
+			Visibility:  types.StringPointerValue(project.Visibility),
+		}
+		// The last update of a deleted project is its deletion
+		if project.LastUpdateTime != nil {
+			deletionTime := project.LastUpdateTime.Time
+			projectModel.DeletionTime = types.StringValue(deletionTime.Format(time.RFC3339))
+			projectModel.PermanentDeletionTime = types.StringValue(deletionTime.AddDate(0, 0, core.ProjectRetentionDays).Format(time.RFC3339))
+		}
+		projectModels = append(projectModels, projectModel)
+	}
+
+	sort.Slice(projectModels, func(i, j int) bool {
+		return strings.ToLower(projectModels[i].Name) < strings.ToLower(projectModels[j].Name)
+	})
+	model.Projects = projectModels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
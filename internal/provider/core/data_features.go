@@ -0,0 +1,150 @@
+package core
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"sort"
+	"strings"
+)
+
+var _ datasource.DataSource = &FeaturesDataSource{}
+
+func NewFeaturesDataSource() datasource.DataSource {
+	return &FeaturesDataSource{}
+}
+
+type FeaturesDataSource struct {
+	client *core.Client
+}
+
+type FeaturesDataSourceModel struct {
+	Features  []FeaturesDataSourceFeatureModel `tfsdk:"features"`
+	ProjectId string                           `tfsdk:"project_id"`
+}
+
+type FeaturesDataSourceFeatureModel struct {
+	DefaultState string       `tfsdk:"default_state"`
+	Description  types.String `tfsdk:"description"`
+	Id           string       `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	State        types.String `tfsdk:"state"`
+}
+
+func (d *FeaturesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_features"
+}
+
+func (d *FeaturesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the features of the organization that can be enabled or disabled for a project within Azure DevOps, and their states in an existing project.",
+		Attributes: map[string]schema.Attribute{
+			"features": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of features, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"default_state": schema.StringAttribute{
+							MarkdownDescription: "The state of the feature when it is not set for the project, `enabled` or `disabled`.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the feature.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The contributed feature ID, used as key of the `features` attribute of the `azuredevops_project_features` resource.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the feature.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The state of the feature in the project, `enabled` or `disabled`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+		},
+	}
+}
+
+func (d *FeaturesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+}
+
+func (d *FeaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model FeaturesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	features, err := d.client.GetFeatures(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve the features of the organization", err.Error())
+		return
+	}
+
+	var featureIds []string
+	for i := range *features {
+		if feature := &(*features)[i]; feature.Id != nil && isProjectFeature(feature) {
+			featureIds = append(featureIds, *feature.Id)
+		}
+	}
+
+	model.Features = []FeaturesDataSourceFeatureModel{}
+	if len(featureIds) > 0 {
+		featureStates, err := d.client.GetProjectFeatures(ctx, model.ProjectId, featureIds)
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				resp.Diagnostics.AddError("Project does not exist", err.Error())
+				return
+			}
+
+			resp.Diagnostics.AddError("Unable to retrieve project features", err.Error())
+			return
+		}
+
+		for _, featureId := range featureIds {
+			feature := findFeature(features, featureId)
+			var state core.ContributedFeatureState
+			if featureStates.FeatureStates != nil {
+				state = (*featureStates.FeatureStates)[featureId]
+			}
+			model.Features = append(model.Features, FeaturesDataSourceFeatureModel{
+				DefaultState: utils.IfThenElse[string](feature.DefaultState != nil && *feature.DefaultState, stateEnabled, stateDisabled),
+				Description:  types.StringPointerValue(feature.Description),
+				Id:           featureId,
+				Name:         types.StringPointerValue(feature.Name),
+				State:        types.StringPointerValue(state.State),
+			})
+		}
+	}
+
+	sort.Slice(model.Features, func(i, j int) bool {
+		return strings.ToLower(model.Features[i].Id) < strings.ToLower(model.Features[j].Id)
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,54 @@
+package core_test
+
+import (
+	"encoding/base64"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAccProcessDataSource_Cassette(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	steps := []resource.TestStep{
+		{
+			Config: testAccProcessConfig(server.URL),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.azuredevops_process.agile", "id", "adcc42ab-9882-485e-a3ed-7678f01f66bc"),
+				resource.TestCheckResourceAttr("data.azuredevops_process.agile", "is_default", "true"),
+			),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.CassetteProviderFactories(networking.CassetteModeRecord, path),
+		Steps:                    steps,
+	})
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := base64.StdEncoding.EncodeToString([]byte(":acctest")); strings.Contains(string(content), token) {
+		t.Fatal("the cassette contains the personal access token")
+	}
+
+	// The server is closed, so the data source can only be read from the cassette
+	server.Close()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.CassetteProviderFactories(networking.CassetteModeReplay, path),
+		Steps:                    steps,
+	})
+}
+
+func testAccProcessConfig(organizationUrl string) string {
+	return acctest.ProviderConfig(organizationUrl) + `
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+`
+}
@@ -85,7 +85,7 @@ func (d *ProjectFeaturesDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	features, err := d.client.GetProjectFeatures(ctx, model.ProjectId)
+	features, err := d.client.GetProjectFeatures(ctx, model.ProjectId, nil)
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
 			resp.Diagnostics.AddError("Project does not exist", err.Error())
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ datasource.DataSource = &ProjectPropertiesDataSource{}
+
+func NewProjectPropertiesDataSource() datasource.DataSource {
+	return &ProjectPropertiesDataSource{}
+}
+
+type ProjectPropertiesDataSource struct {
+	client *core.Client
+}
+
+type ProjectPropertiesDataSourceModel struct {
+	IncludeSystem *bool             `tfsdk:"include_system"`
+	Keys          []string          `tfsdk:"keys"`
+	ProjectId     string            `tfsdk:"project_id"`
+	Properties    map[string]string `tfsdk:"properties"`
+}
+
+func (d *ProjectPropertiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_properties"
+}
+
+func (d *ProjectPropertiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to access information about the properties of an existing project within Azure DevOps.",
+		Attributes: map[string]schema.Attribute{
+			"include_system": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to also return the properties set by Azure DevOps, whose names start with `System.`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"keys": schema.ListAttribute{
+				MarkdownDescription: "Only returns the properties with these names.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "The properties of the project, by name. The values that are not strings are encoded in JSON.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ProjectPropertiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+}
+
+func (d *ProjectPropertiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model ProjectPropertiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties, err := d.client.GetProjectProperties(ctx, model.ProjectId, model.Keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve project properties", err.Error())
+		return
+	}
+
+	model.Properties = getProjectPropertiesMap(properties, model.IncludeSystem != nil && *model.IncludeSystem)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+type ProjectsDataSource struct {
+	client *core.Client
+}
+
+type ProjectsDataSourceModel struct {
+	IncludeCapabilities *bool                            `tfsdk:"include_capabilities"`
+	NamePrefix          *string                          `tfsdk:"name_prefix"`
+	NameRegex           *string                          `tfsdk:"name_regex"`
+	Projects            []ProjectsDataSourceProjectModel `tfsdk:"projects"`
+	State               *string                          `tfsdk:"state"`
+	Visibility          *string                          `tfsdk:"visibility"`
+}
+
+type ProjectsDataSourceProjectModel struct {
+	Description       types.String `tfsdk:"description"`
+	Id                string       `tfsdk:"id"`
+	LastUpdateTime    types.String `tfsdk:"last_update_time"`
+	Name              string       `tfsdk:"name"`
+	ProcessTemplateId types.String `tfsdk:"process_template_id"`
+	State             types.String `tfsdk:"state"`
+	VersionControl    types.String `tfsdk:"version_control"`
+	Visibility        types.String `tfsdk:"visibility"`
+}
+
+func (d *ProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to access information about existing projects within Azure DevOps, e.g. to apply the same settings to every project of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"include_capabilities": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to also return the process and the version control of the projects, which are only returned by a request per project. Defaults to `false`, the `process_template_id` and `version_control` of the projects being then null.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only returns the projects whose name starts with this prefix, compared case-insensitively.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only returns the projects whose name matches this regular expression, e.g. `(?i)^team-`.",
+				Optional:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of projects, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the project.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"last_update_time": schema.StringAttribute{
+							MarkdownDescription: "The time of the last update of the project, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"process_template_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the process of the project, only set when `include_capabilities` is true.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The state of the project.",
+							Computed:            true,
+						},
+						"version_control": schema.StringAttribute{
+							MarkdownDescription: "The version control of the project, `Git` or `Tfvc`, only set when `include_capabilities` is true.",
+							Computed:            true,
+						},
+						"visibility": schema.StringAttribute{
+							MarkdownDescription: "The visibility of the project, `private` or `public`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Only returns the projects in this state. Must be `all`, `createPending`, `deleting`, `new`, `unchanged` or `wellFormed`. Defaults to `wellFormed`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("all", "createPending", "deleting", "new", "unchanged", "wellFormed"),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Only returns the projects with this visibility. Must be `private` or `public`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("private", "public"),
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model ProjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if model.NameRegex != nil {
+		var err error
+		nameRegex, err = regexp.Compile(*model.NameRegex)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	state := core.ProjectStateWellFormed
+	if model.State != nil {
+		state = *model.State
+	}
+
+	projects, err := d.client.GetProjects(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve projects", err.Error())
+		return
+	}
+
+	projectModels := []ProjectsDataSourceProjectModel{}
+	for _, project := range *projects {
+		if model.NamePrefix != nil && !strings.HasPrefix(strings.ToLower(*project.Name), strings.ToLower(*model.NamePrefix)) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(*project.Name) {
+			continue
+		}
+		if model.Visibility != nil && (project.Visibility == nil || !strings.EqualFold(*project.Visibility, *model.Visibility)) {
+			continue
+		}
+
+		projectModel := ProjectsDataSourceProjectModel{
+			Description: types.StringPointerValue(project.Description),
+			Id:          project.Id.String(),
+			Name:        *project.Name,
+			Visibility:  types.StringPointerValue(project.Visibility),
+		}
+		if project.LastUpdateTime != nil {
+			projectModel.LastUpdateTime = types.StringValue(project.LastUpdateTime.Time.Format(time.RFC3339))
+		}
+		if project.State != nil {
+			projectModel.State = types.StringValue(string(*project.State))
+		}
+		if model.IncludeCapabilities != nil && *model.IncludeCapabilities {
+			// The process and the version control are only returned with the capabilities of a single project
+			teamProject, err := d.client.GetProject(ctx, project.Id.String())
+			if err != nil {
+				if utils.ResponseWasNotFound(err) {
+					continue
+				}
+
+				resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve project '%s'", *project.Name), err.Error())
+				return
+			}
+
+			if teamProject.Capabilities != nil {
+				projectModel.ProcessTemplateId = types.StringValue((*teamProject.Capabilities)[core.CapabilitiesProcessTemplate][core.CapabilitiesProcessTemplateTypeId])
+				projectModel.VersionControl = types.StringValue((*teamProject.Capabilities)[core.CapabilitiesVersionControl][core.CapabilitiesVersionControlType])
+			}
+		}
+		projectModels = append(projectModels, projectModel)
+	}
+
+	sort.Slice(projectModels, func(i, j int) bool {
+		return strings.ToLower(projectModels[i].Name) < strings.ToLower(projectModels[j].Name)
+	})
+	model.Projects = projectModels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
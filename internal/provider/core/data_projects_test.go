@@ -0,0 +1,117 @@
+package core_test
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"testing"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	server := acctest.NewServer(t, &fakeserver.ServerConfig{PendingPolls: 1})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectsConfig(server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The projects are sorted by name regardless of its case
+					resource.TestCheckResourceAttr("data.azuredevops_projects.test", "projects.#", "3"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.test", "projects.0.name", "sandbox"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.test", "projects.1.name", "team-alpha"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.test", "projects.2.name", "Team-Beta"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.test", "projects.2.state", "wellFormed"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.test", "projects.2.visibility", "public"),
+					resource.TestCheckNoResourceAttr("data.azuredevops_projects.test", "projects.0.process_template_id"),
+					resource.TestCheckNoResourceAttr("data.azuredevops_projects.test", "projects.0.version_control"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.prefix", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.prefix", "projects.0.name", "team-alpha"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.prefix", "projects.1.name", "Team-Beta"),
+					resource.TestCheckResourceAttrPair("data.azuredevops_projects.prefix", "projects.0.process_template_id", "data.azuredevops_process.agile", "id"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.prefix", "projects.1.version_control", "Tfvc"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.regex", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.regex", "projects.0.name", "sandbox"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.regex", "projects.1.name", "team-alpha"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.public", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.azuredevops_projects.public", "projects.0.id", "azuredevops_project.beta", "id"),
+				),
+			},
+			{
+				// A project whose creation is still pending is only returned for its state
+				PreConfig: func() {
+					_, err := acctest.NewClient(server).CoreClient.CreateProject(context.Background(), "pending", "", "private", "adcc42ab-9882-485e-a3ed-7678f01f66bc", "Git")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProjectsConfig(server.URL) + `
+data "azuredevops_projects" "all" {
+  state = "all"
+}
+
+data "azuredevops_projects" "pending" {
+  state = "createPending"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azuredevops_projects.test", "projects.#", "3"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.all", "projects.#", "4"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.all", "projects.0.name", "pending"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.pending", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.azuredevops_projects.pending", "projects.0.state", "createPending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectsConfig(organizationUrl string) string {
+	return acctest.ProviderConfig(organizationUrl) + `
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_project" "alpha" {
+  name                = "team-alpha"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Git"
+  visibility          = "private"
+}
+
+resource "azuredevops_project" "beta" {
+  name                = "Team-Beta"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Tfvc"
+  visibility          = "public"
+}
+
+resource "azuredevops_project" "sandbox" {
+  name                = "sandbox"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Git"
+  visibility          = "private"
+}
+
+data "azuredevops_projects" "test" {
+  depends_on = [azuredevops_project.alpha, azuredevops_project.beta, azuredevops_project.sandbox]
+}
+
+data "azuredevops_projects" "prefix" {
+  depends_on           = [azuredevops_project.alpha, azuredevops_project.beta, azuredevops_project.sandbox]
+  include_capabilities = true
+  name_prefix          = "TEAM-"
+}
+
+data "azuredevops_projects" "regex" {
+  depends_on = [azuredevops_project.alpha, azuredevops_project.beta, azuredevops_project.sandbox]
+  name_regex = "^[a-z-]+$"
+}
+
+data "azuredevops_projects" "public" {
+  depends_on = [azuredevops_project.alpha, azuredevops_project.beta, azuredevops_project.sandbox]
+  visibility = "public"
+}
+`
+}
@@ -3,21 +3,28 @@ package core
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 	"strings"
+	"time"
 )
 
 var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -28,22 +35,32 @@ type ProjectResource struct {
 }
 
 type ProjectResourceModel struct {
-	Description       *string      `tfsdk:"description"`
-	Id                types.String `tfsdk:"id"`
-	Name              string       `tfsdk:"name"`
-	ProcessTemplateId string       `tfsdk:"process_template_id"`
-	VersionControl    string       `tfsdk:"version_control"`
-	Visibility        string       `tfsdk:"visibility"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Description        *string        `tfsdk:"description"`
+	Id                 types.String   `tfsdk:"id"`
+	Name               string         `tfsdk:"name"`
+	PermanentlyDelete  types.Bool     `tfsdk:"permanently_delete"`
+	ProcessTemplateId  string         `tfsdk:"process_template_id"`
+	RestoreDeleted     types.Bool     `tfsdk:"restore_deleted"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	VersionControl     string         `tfsdk:"version_control"`
+	Visibility         string         `tfsdk:"visibility"`
 }
 
 func (r *ProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
 
-func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ProjectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage a project within Azure DevOps.",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to refuse to delete the project, including when a change of `version_control` would replace it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the project.",
 				Optional:            true,
@@ -59,28 +76,46 @@ func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "The name of the project.",
 				Required:            true,
 			},
+			"permanently_delete": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to delete the project permanently on destroy. Otherwise, the project is moved to the recycle bin, where it can be restored for 28 days. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"process_template_id": schema.StringAttribute{
-				MarkdownDescription: "The process template ID of the project.",
+				MarkdownDescription: "The process template ID of the project. Changing it migrates the project to the new process, which Azure DevOps only allows between the inherited processes of a same system process and their system process.",
 				Required:            true,
 				Validators: []validator.String{
 					validators.UUID(),
 				},
 			},
+			"restore_deleted": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to restore a project with the same name from the recycle bin instead of creating a new project, e.g. when a project destroyed by mistake is applied again. The restored project is then updated to match the configuration. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"version_control": schema.StringAttribute{
-				MarkdownDescription: "Specifies the visibility of the project. Must be `Git` or `Tfvc`.",
+				MarkdownDescription: "The version control of the project. Must be `Git` or `Tfvc`. Changing it replaces the project.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOfCaseInsensitive("Git", "Tfvc"),
 				},
 			},
 			"visibility": schema.StringAttribute{
-				MarkdownDescription: "Specifies the visibility of the project. Must be `private` or `public`.",
+				MarkdownDescription: "Specifies the visibility of the project. Must be `private` or `public`. Public projects must be allowed by the policies of the organization.",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOfCaseInsensitive("private", "public"),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -100,14 +135,43 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	description := utils.IfThenElse[*string](model.Description != nil, model.Description, utils.EmptyString)
-	operation, err := r.client.CreateProject(ctx, model.Name, *description, model.Visibility, model.ProcessTemplateId, model.VersionControl)
+	timeout, diags := model.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deletedProject *core.TeamProjectReference
+	var err error
+	if model.RestoreDeleted.ValueBool() {
+		deletedProject, err = r.client.FindDeletedProject(ctx, model.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to search the recycle bin for project", err.Error())
+			return
+		}
+	}
+
+	var operation *core.OperationReference
+	if deletedProject != nil {
+		logger.Info(ctx, "Restoring project from the recycle bin", map[string]any{
+			"project_id":   deletedProject.Id.String(),
+			"project_name": model.Name,
+		})
+		operation, err = r.client.RestoreProject(ctx, deletedProject.Id.String())
+	} else {
+		description := utils.IfThenElse[*string](model.Description != nil, model.Description, utils.EmptyString)
+		operation, err = r.client.CreateProject(ctx, model.Name, *description, model.Visibility, model.ProcessTemplateId, model.VersionControl)
+	}
+	if utils.ResponseWasConflict(err) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), fmt.Sprintf("Project '%s' already exists", model.Name), fmt.Sprintf("%s\n\nImport the existing project, choose another name, or set 'restore_deleted' to restore a project from the recycle bin.", err.Error()))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create project", err.Error())
 		return
 	}
 
-	stateConf := r.client.OperationStateChangeConf(ctx, r.client, operation)
+	stateConf := r.client.OperationStateChangeConf(ctx, r.client, operation, timeout)
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		resp.Diagnostics.AddError("Waiting for project ready", err.Error())
 		return
@@ -121,6 +185,33 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	model.Id = types.StringValue(project.Id.String())
 
+	if deletedProject != nil {
+		// The restored project keeps the settings it had when deleted
+		restoredModel := &ProjectResourceModel{
+			Description:       project.Description,
+			Name:              *project.Name,
+			ProcessTemplateId: (*project.Capabilities)[core.CapabilitiesProcessTemplate][core.CapabilitiesProcessTemplateTypeId],
+			VersionControl:    (*project.Capabilities)[core.CapabilitiesVersionControl][core.CapabilitiesVersionControlType],
+			Visibility:        *project.Visibility,
+		}
+		if !strings.EqualFold(restoredModel.VersionControl, model.VersionControl) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Restored project '%s' uses version control '%s'", model.Name, restoredModel.VersionControl), "The version control of a project cannot be changed, the restored project must be replaced to use the configured version control.")
+			model.VersionControl = restoredModel.VersionControl
+			resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+			return
+		}
+
+		updated, diags := r.updateProject(ctx, restoredModel, model, timeout)
+		resp.Diagnostics.Append(diags...)
+		if !updated {
+			// The restored project is kept in the state with the settings it had when deleted
+			model.Description = restoredModel.Description
+			model.Name = restoredModel.Name
+			model.ProcessTemplateId = restoredModel.ProcessTemplateId
+			model.Visibility = restoredModel.Visibility
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -132,6 +223,15 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	timeout, diags := model.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	project, err := r.client.GetProject(ctx, model.Id.ValueString())
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
@@ -163,17 +263,15 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	name := utils.IfThenElse[string](strings.EqualFold(currentModel.Name, newModel.Name), "", newModel.Name)
-	description := utils.IfThenElse[*string](newModel.Description != nil, newModel.Description, utils.EmptyString)
-	operation, err := r.client.UpdateProject(ctx, newModel.Id.ValueString(), name, *description)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to update Project", err.Error())
+	timeout, diags := newModel.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	stateConf := r.client.OperationStateChangeConf(ctx, r.client, operation)
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		resp.Diagnostics.AddError("Waiting for project update", err.Error())
+	updated, diags := r.updateProject(ctx, currentModel, newModel, timeout)
+	resp.Diagnostics.Append(diags...)
+	if !updated {
 		return
 	}
 
@@ -188,15 +286,96 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if model.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(fmt.Sprintf("Project '%s' is protected against deletion", model.Name), "Set 'deletion_protection' to false and apply the configuration before deleting the project.")
+		return
+	}
+
+	timeout, diags := model.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	operation, err := r.client.DeleteProject(ctx, model.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Project with Id '%s' failed to delete", model.Id.ValueString()), err.Error())
 		return
 	}
 
-	stateConf := r.client.OperationStateChangeConf(ctx, r.client, operation)
+	stateConf := r.client.OperationStateChangeConf(ctx, r.client, operation, timeout)
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		resp.Diagnostics.AddError("Waiting for project delete", err.Error())
 		return
 	}
+
+	if !model.PermanentlyDelete.ValueBool() {
+		return
+	}
+
+	// Deleting a project of the recycle bin deletes it permanently
+	operation, err = r.client.DeleteProject(ctx, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Project with Id '%s' failed to delete permanently", model.Id.ValueString()), err.Error())
+		return
+	}
+
+	stateConf = r.client.OperationStateChangeConf(ctx, r.client, operation, timeout)
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Waiting for project permanent delete", err.Error())
+		return
+	}
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, err := r.client.GetProjectId(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", req.ID), err.Error())
+		return
+	}
+
+	model := &ProjectResourceModel{
+		DeletionProtection: types.BoolValue(false),
+		Id:                 types.StringValue(projectId),
+		PermanentlyDelete:  types.BoolValue(false),
+		RestoreDeleted:     types.BoolValue(false),
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Private Methods
+
+// updateProject applies the changes from currentModel to newModel, and returns whether the name, the description and
+// the visibility were updated. The state must then be saved even on error: when the migration of the process fails,
+// the process of newModel is reset to the current one, so that only the applied changes are recorded.
+func (r *ProjectResource) updateProject(ctx context.Context, currentModel *ProjectResourceModel, newModel *ProjectResourceModel, timeout time.Duration) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := utils.IfThenElse[string](strings.EqualFold(currentModel.Name, newModel.Name), "", newModel.Name)
+	description := utils.IfThenElse[*string](newModel.Description != nil, newModel.Description, utils.EmptyString)
+	visibility := utils.IfThenElse[string](strings.EqualFold(currentModel.Visibility, newModel.Visibility), "", newModel.Visibility)
+	operation, err := r.client.UpdateProject(ctx, newModel.Id.ValueString(), name, *description, visibility)
+	if err != nil {
+		diags.AddError("Failed to update Project", err.Error())
+		return false, diags
+	}
+
+	stateConf := r.client.OperationStateChangeConf(ctx, r.client, operation, timeout)
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		diags.AddError("Waiting for project update", err.Error())
+		return false, diags
+	}
+
+	if !strings.EqualFold(currentModel.ProcessTemplateId, newModel.ProcessTemplateId) {
+		_, err = r.client.MigrateProjectProcess(ctx, newModel.Id.ValueString(), newModel.ProcessTemplateId)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to migrate project to process '%s'", newModel.ProcessTemplateId), err.Error())
+			// The other changes have been applied, only the process is left unchanged
+			newModel.ProcessTemplateId = currentModel.ProcessTemplateId
+			return true, diags
+		}
+	}
+
+	return true, diags
 }
@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -10,6 +12,9 @@ import (
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"strings"
 )
 
 const (
@@ -18,6 +23,8 @@ const (
 )
 
 var _ resource.Resource = &ProjectFeaturesResource{}
+var _ resource.ResourceWithImportState = &ProjectFeaturesResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectFeaturesResource{}
 
 func NewProjectFeaturesResource() resource.Resource {
 	return &ProjectFeaturesResource{}
@@ -28,12 +35,13 @@ type ProjectFeaturesResource struct {
 }
 
 type ProjectFeaturesResourceModel struct {
-	Artifacts    string `tfsdk:"artifacts"`
-	Boards       string `tfsdk:"boards"`
-	Pipelines    string `tfsdk:"pipelines"`
-	ProjectId    string `tfsdk:"project_id"`
-	Repositories string `tfsdk:"repositories"`
-	TestPlans    string `tfsdk:"testplans"`
+	Artifacts    string            `tfsdk:"artifacts"`
+	Boards       string            `tfsdk:"boards"`
+	Features     map[string]string `tfsdk:"features"`
+	Pipelines    string            `tfsdk:"pipelines"`
+	ProjectId    string            `tfsdk:"project_id"`
+	Repositories string            `tfsdk:"repositories"`
+	TestPlans    string            `tfsdk:"testplans"`
 }
 
 func (r *ProjectFeaturesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,6 +67,15 @@ func (r *ProjectFeaturesResource) Schema(_ context.Context, _ resource.SchemaReq
 					validators.EnabledDisabled(),
 				},
 			},
+			"features": schema.MapAttribute{
+				MarkdownDescription: "The states of other features of the project, e.g. previews or features of extensions, by contributed feature ID. The states must be `enabled` or `disabled`. Only the declared features are managed, and their default states are restored when they are removed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(projectFeatureIdValidator{}),
+					mapvalidator.ValueStringsAre(validators.EnabledDisabled()),
+				},
+			},
 			"pipelines": schema.StringAttribute{
 				MarkdownDescription: "If enabled, gives access to Azure Pipelines.",
 				Required:            true,
@@ -124,7 +141,7 @@ func (r *ProjectFeaturesResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	features, err := r.client.GetProjectFeatures(ctx, model.ProjectId)
+	features, err := r.client.GetProjectFeatures(ctx, model.ProjectId, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to retrieve project features", err.Error())
 		return
@@ -137,6 +154,29 @@ func (r *ProjectFeaturesResource) Read(ctx context.Context, req resource.ReadReq
 	model.Repositories = *featureStates[core.ProjectFeatureRepositories].State
 	model.TestPlans = *featureStates[core.ProjectFeatureTestPlans].State
 
+	if len(model.Features) > 0 {
+		features, err = r.client.GetProjectFeatures(ctx, model.ProjectId, maps.Keys(model.Features))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to retrieve project features", err.Error())
+			return
+		}
+
+		// The states are keyed by the IDs of the features, which may not have the case of the configured IDs. The
+		// features missing from the response, e.g. retired, are removed so that they are planned again.
+		featureStates := map[string]string{}
+		for featureId, featureState := range *features.FeatureStates {
+			if featureState.State == nil {
+				continue
+			}
+			for configuredId := range model.Features {
+				if strings.EqualFold(configuredId, featureId) {
+					featureStates[configuredId] = *featureState.State
+				}
+			}
+		}
+		model.Features = featureStates
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -174,14 +214,84 @@ func (r *ProjectFeaturesResource) Delete(ctx context.Context, req resource.Delet
 	model.Repositories = stateEnabled
 	model.TestPlans = stateEnabled
 
+	for featureId := range model.Features {
+		_, err := r.client.UpdateProjectFeature(ctx, model.ProjectId, featureId, core.FeatureStateUndefined)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to delete project features", err.Error())
+			return
+		}
+	}
+	model.Features = nil
+
 	err := r.updateFeatures(ctx, nil, model)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete project features", err.Error())
 	}
 }
 
+func (r *ProjectFeaturesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, err := r.client.GetProjectId(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProjectFeaturesResourceModel{ProjectId: projectId})...)
+}
+
+func (r *ProjectFeaturesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The features are validated against the catalogue of the organization, once the provider is configured
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var featureStates types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("features"), &featureStates)...)
+	if resp.Diagnostics.HasError() || featureStates.IsNull() || featureStates.IsUnknown() || len(featureStates.Elements()) == 0 {
+		return
+	}
+
+	features, err := r.client.GetFeatures(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve the features of the organization", err.Error())
+		return
+	}
+
+	for featureId := range featureStates.Elements() {
+		feature := findFeature(features, featureId)
+		if feature == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("features").AtMapKey(featureId), "Unknown feature", fmt.Sprintf("The feature '%s' is not contributed to the organization.", featureId))
+		} else if !isProjectFeature(feature) {
+			resp.Diagnostics.AddAttributeError(path.Root("features").AtMapKey(featureId), "Feature not managed by project", fmt.Sprintf("The feature '%s' cannot be enabled or disabled for a project.", featureId))
+		}
+	}
+}
+
 // Private Methods
 
+func findFeature(features *[]core.ContributedFeature, featureId string) *core.ContributedFeature {
+	for i, feature := range *features {
+		if feature.Id != nil && strings.EqualFold(*feature.Id, featureId) {
+			return &(*features)[i]
+		}
+	}
+	return nil
+}
+
+// isProjectFeature returns true when a feature can be enabled or disabled for a whole project.
+func isProjectFeature(feature *core.ContributedFeature) bool {
+	if feature.Scopes == nil {
+		return false
+	}
+
+	for _, scope := range *feature.Scopes {
+		if scope.SettingScope != nil && *scope.SettingScope == core.FeatureSettingScopeProject && (scope.UserScoped == nil || !*scope.UserScoped) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *ProjectFeaturesResource) updateFeatures(ctx context.Context, currentModel *ProjectFeaturesResourceModel, newModel *ProjectFeaturesResourceModel) error {
 	if currentModel == nil || currentModel.Artifacts != newModel.Artifacts {
 		_, err := r.client.UpdateProjectFeature(ctx, newModel.ProjectId, core.ProjectFeatureArtifacts, newModel.Artifacts)
@@ -218,6 +328,27 @@ func (r *ProjectFeaturesResource) updateFeatures(ctx context.Context, currentMod
 		}
 	}
 
+	// The features removed from the configuration are restored to their default states
+	if currentModel != nil {
+		for featureId := range currentModel.Features {
+			if _, ok := newModel.Features[featureId]; !ok {
+				_, err := r.client.UpdateProjectFeature(ctx, newModel.ProjectId, featureId, core.FeatureStateUndefined)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for featureId, state := range newModel.Features {
+		if currentModel == nil || currentModel.Features[featureId] != state {
+			_, err := r.client.UpdateProjectFeature(ctx, newModel.ProjectId, featureId, state)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -250,3 +381,24 @@ func (v featuresDependenciesValidator) ValidateString(ctx context.Context, req v
 		return
 	}
 }
+
+type projectFeatureIdValidator struct{}
+
+func (v projectFeatureIdValidator) Description(_ context.Context) string {
+	return "Feature is managed by a dedicated attribute"
+}
+
+func (v projectFeatureIdValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectFeatureIdValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	featureIds := []string{core.ProjectFeatureArtifacts, core.ProjectFeatureBoards, core.ProjectFeaturePipelines, core.ProjectFeatureRepositories, core.ProjectFeatureTestPlans}
+	if slices.Contains(featureIds, strings.ToLower(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(req.Path, v.Description(ctx), fmt.Sprintf("The feature '%s' must be managed with the `artifacts`, `boards`, `pipelines`, `repositories` or `testplans` attributes.", req.ConfigValue.ValueString()))
+	}
+}
@@ -0,0 +1,91 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"regexp"
+	"testing"
+)
+
+const newBoardsHubFeatureId = "ms.vss-work-web.new-boards-hub-feature"
+
+func TestAccProjectFeaturesResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	projectId := ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The configured ID of a feature is kept whatever its case
+				Config: testAccProjectFeaturesConfig(server.URL, `
+    "MS.VSS-Work-Web.New-Boards-Hub-Feature" = "enabled"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("azuredevops_project.test", "id", func(value string) error {
+						projectId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("azuredevops_project_features.test", "artifacts", "disabled"),
+					resource.TestCheckResourceAttr("azuredevops_project_features.test", "features.MS.VSS-Work-Web.New-Boards-Hub-Feature", "enabled"),
+					testAccCheckProjectFeature(server, newBoardsHubFeatureId, "enabled"),
+				),
+			},
+			{
+				// A feature changed outside of Terraform is detected
+				PreConfig: func() {
+					_, err := acctest.NewClient(server).CoreClient.UpdateProjectFeature(context.Background(), projectId, newBoardsHubFeatureId, "disabled")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProjectFeaturesConfig(server.URL, `
+    "MS.VSS-Work-Web.New-Boards-Hub-Feature" = "enabled"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The default state of a feature is restored when it is removed
+				Config: testAccProjectFeaturesConfig(server.URL, ""),
+				Check:  testAccCheckProjectFeature(server, newBoardsHubFeatureId, "disabled"),
+			},
+			{
+				Config: testAccProjectFeaturesConfig(server.URL, `
+    "ms.vss-build-web.run-multi-stage-pipelines-preview-feature" = "enabled"`),
+				ExpectError: regexp.MustCompile("cannot be enabled or disabled for a project"),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectFeature(server *fakeserver.Server, featureId string, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		projectId := s.RootModule().Resources["azuredevops_project.test"].Primary.ID
+		features, err := acctest.NewClient(server).CoreClient.GetProjectFeatures(context.Background(), projectId, []string{featureId})
+		if err != nil {
+			return err
+		}
+		if actual := *(*features.FeatureStates)[featureId].State; actual != state {
+			return fmt.Errorf("expected the feature '%s' to be %s, got %s", featureId, state, actual)
+		}
+		return nil
+	}
+}
+
+func testAccProjectFeaturesConfig(organizationUrl string, features string) string {
+	return testAccProjectConfig(organizationUrl, "Managed by Terraform") + fmt.Sprintf(`
+resource "azuredevops_project_features" "test" {
+  artifacts    = "disabled"
+  boards       = "enabled"
+  pipelines    = "enabled"
+  project_id   = azuredevops_project.test.id
+  repositories = "enabled"
+  testplans    = "enabled"
+  features = {%s
+  }
+}
+`, features)
+}
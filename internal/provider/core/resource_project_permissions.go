@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"github.com/ahmetb/go-linq/v3"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -10,9 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
 	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 )
 
@@ -37,12 +40,14 @@ const (
 )
 
 var _ resource.Resource = &ProjectPermissionsResource{}
+var _ resource.ResourceWithImportState = &ProjectPermissionsResource{}
 
 func NewProjectPermissionsResource() resource.Resource {
 	return &ProjectPermissionsResource{}
 }
 
 type ProjectPermissionsResource struct {
+	coreClient     *core.Client
 	graphClient    *graph.Client
 	securityClient *clientSecurity.Client
 }
@@ -264,6 +269,7 @@ func (r *ProjectPermissionsResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.graphClient = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
 	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
 }
@@ -346,6 +352,33 @@ func (r *ProjectPermissionsResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
+func (r *ProjectPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/principalName")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	token := r.securityClient.GetProjectToken(projectId)
+	permissions, err := security.ImportPrincipalPermissions(ctx, clientSecurity.NamespaceIdProject, token, parts[1], r.securityClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve access control lists", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProjectPermissionsResourceModel{
+		PrincipalDescriptor: types.StringValue(permissions.PrincipalDescriptor),
+		PrincipalName:       permissions.PrincipalName,
+		ProjectId:           projectId,
+	})...)
+}
+
 // Private Methods
 
 func (r *ProjectPermissionsResource) getPermissions(model *ProjectPermissionsResourceModel) *security.PrincipalPermissions {
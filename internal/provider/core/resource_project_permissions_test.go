@@ -0,0 +1,147 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"strings"
+	"testing"
+)
+
+// Bits of the actions of the Project security namespace
+const (
+	projectBitGenericRead = 1
+	projectBitDelete      = 4
+)
+
+func TestAccProjectPermissionsResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	principalDescriptor := ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPermissionsConfig(server.URL, "allow", "deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("azuredevops_project_permissions.test", "principal_descriptor", func(value string) error {
+						principalDescriptor = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("azuredevops_project_permissions.test", "permissions.general.read", "allow"),
+					resource.TestCheckResourceAttr("azuredevops_project_permissions.test", "permissions.general.delete", "deny"),
+					resource.TestCheckResourceAttr("azuredevops_project_permissions.test", "permissions.general.write", "notset"),
+					testAccCheckProjectAccessControlEntry(server, projectBitGenericRead, projectBitDelete),
+				),
+			},
+			{
+				Config: testAccProjectPermissionsConfig(server.URL, "deny", "notset"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_project_permissions.test", "permissions.general.read", "deny"),
+					resource.TestCheckResourceAttr("azuredevops_project_permissions.test", "permissions.general.delete", "notset"),
+					testAccCheckProjectAccessControlEntry(server, 0, projectBitGenericRead),
+				),
+			},
+			{
+				ResourceName:                         "azuredevops_project_permissions.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/[Sandbox]\\Contributors",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "principal_descriptor",
+			},
+			{
+				// Removing the resource removes the access control entry of the principal
+				Config: testAccProjectConfig(server.URL, "Managed by Terraform"),
+				Check:  testAccCheckProjectAccessControlEntryRemoved(server, &principalDescriptor),
+			},
+		},
+	})
+}
+
+// getProjectAccessControlEntry returns the access control entry of the principal of the permissions on the project, nil
+// when the principal has none.
+func getProjectAccessControlEntry(server *fakeserver.Server, s *terraform.State, principalDescriptor string) (*security.AccessControlEntry, error) {
+	projectId := s.RootModule().Resources["azuredevops_project.test"].Primary.ID
+	client := acctest.NewClient(server).SecurityClient
+	acls, err := client.GetAccessControlLists(context.Background(), security.NamespaceIdProject, client.GetProjectToken(projectId))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, acl := range *acls.Value {
+		for descriptor, ace := range *acl.AcesDictionary {
+			if strings.EqualFold(descriptor, principalDescriptor) {
+				return &ace, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func testAccCheckProjectAccessControlEntry(server *fakeserver.Server, allow int, deny int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		principalDescriptor := s.RootModule().Resources["azuredevops_project_permissions.test"].Primary.Attributes["principal_descriptor"]
+		ace, err := getProjectAccessControlEntry(server, s, principalDescriptor)
+		if err != nil {
+			return err
+		}
+		if ace == nil {
+			return fmt.Errorf("access control entry of '%s' not found", principalDescriptor)
+		}
+		if *ace.Allow != allow || *ace.Deny != deny {
+			return fmt.Errorf("expected allow %d and deny %d, got allow %d and deny %d", allow, deny, *ace.Allow, *ace.Deny)
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectAccessControlEntryRemoved(server *fakeserver.Server, principalDescriptor *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ace, err := getProjectAccessControlEntry(server, s, *principalDescriptor)
+		if err != nil {
+			return err
+		}
+		if ace != nil {
+			return fmt.Errorf("access control entry of '%s' still exists", *ace.Descriptor)
+		}
+		return nil
+	}
+}
+
+func testAccProjectPermissionsConfig(organizationUrl string, read string, delete string) string {
+	return testAccProjectConfig(organizationUrl, "Managed by Terraform") + fmt.Sprintf(`
+resource "azuredevops_project_permissions" "test" {
+  principal_name = "[Sandbox]\\Contributors"
+  project_id     = azuredevops_project.test.id
+  permissions = {
+    boards = {
+      bypass_rules                = "notset"
+      change_process              = "notset"
+      workitem_delete             = "notset"
+      workitem_move               = "notset"
+      workitem_permanently_delete = "notset"
+    }
+    general = {
+      delete                 = %q
+      manage_properties      = "notset"
+      rename                 = "notset"
+      read                   = %q
+      suppress_notifications = "notset"
+      update_visibility      = "notset"
+      write                  = "notset"
+    }
+    test_plans = {
+      delete_test_results        = "notset"
+      manage_test_configurations = "notset"
+      manage_test_environments   = "notset"
+      publish_test_results       = "notset"
+      view_test_results          = "notset"
+    }
+  }
+}
+`, delete, read)
+}
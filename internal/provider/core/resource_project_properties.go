@@ -0,0 +1,263 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"golang.org/x/exp/maps"
+	"strings"
+)
+
+var _ resource.Resource = &ProjectPropertiesResource{}
+var _ resource.ResourceWithImportState = &ProjectPropertiesResource{}
+
+func NewProjectPropertiesResource() resource.Resource {
+	return &ProjectPropertiesResource{}
+}
+
+type ProjectPropertiesResource struct {
+	client *core.Client
+}
+
+type ProjectPropertiesResourceModel struct {
+	Authoritative types.Bool        `tfsdk:"authoritative"`
+	ProjectId     string            `tfsdk:"project_id"`
+	Properties    map[string]string `tfsdk:"properties"`
+}
+
+func (r *ProjectPropertiesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_properties"
+}
+
+func (r *ProjectPropertiesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage custom properties of an existing project within Azure DevOps, e.g. to store metadata read by other tools.",
+		Attributes: map[string]schema.Attribute{
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to delete the properties of the project that are not declared in `properties`, except the properties set by Azure DevOps. Otherwise, only the declared properties are managed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "The properties of the project, by name. The names starting with `System.` are reserved by Azure DevOps.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(projectPropertyNameValidator{}),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectPropertiesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+}
+
+func (r *ProjectPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateProperties(ctx, nil, model)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update project properties", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProjectPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All the properties are read when authoritative or imported, only the managed ones otherwise
+	var keys []string
+	if !model.Authoritative.ValueBool() && model.Properties != nil {
+		keys = maps.Keys(model.Properties)
+		if len(keys) == 0 {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+			return
+		}
+	}
+
+	properties, err := r.client.GetProjectProperties(ctx, model.ProjectId, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve project properties", err.Error())
+		return
+	}
+
+	model.Properties = getProjectPropertiesMap(properties, false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProjectPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var currentModel *ProjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentModel)...)
+
+	var newModel *ProjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &newModel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateProperties(ctx, currentModel, newModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update project properties", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
+}
+
+func (r *ProjectPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProjectPropertiesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetProjectProperties(ctx, model.ProjectId, nil, maps.Keys(model.Properties))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete project properties", err.Error())
+	}
+}
+
+func (r *ProjectPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, err := r.client.GetProjectId(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProjectPropertiesResourceModel{Authoritative: types.BoolValue(false), ProjectId: projectId})...)
+}
+
+// Private Methods
+
+// getProjectPropertiesMap converts the properties of a project to strings, the values that are not strings being
+// encoded in JSON. The properties set by Azure DevOps are only kept when includeSystem is true.
+func getProjectPropertiesMap(properties *[]core.ProjectProperty, includeSystem bool) map[string]string {
+	result := map[string]string{}
+	if properties == nil {
+		return result
+	}
+
+	for _, property := range *properties {
+		if property.Name == nil || (!includeSystem && isSystemProjectProperty(*property.Name)) {
+			continue
+		}
+
+		switch value := property.Value.(type) {
+		case string:
+			result[*property.Name] = value
+		case nil:
+			result[*property.Name] = ""
+		default:
+			encoded, _ := json.Marshal(value)
+			result[*property.Name] = string(encoded)
+		}
+	}
+	return result
+}
+
+func isSystemProjectProperty(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), strings.ToLower(core.ProjectPropertySystemPrefix))
+}
+
+func (r *ProjectPropertiesResource) updateProperties(ctx context.Context, currentModel *ProjectPropertiesResourceModel, newModel *ProjectPropertiesResourceModel) error {
+	var removedKeys []string
+	if newModel.Authoritative.ValueBool() {
+		properties, err := r.client.GetProjectProperties(ctx, newModel.ProjectId, nil)
+		if err != nil {
+			return err
+		}
+
+		for key := range getProjectPropertiesMap(properties, false) {
+			if _, ok := newModel.Properties[key]; !ok {
+				removedKeys = append(removedKeys, key)
+			}
+		}
+	} else if currentModel != nil {
+		for key := range currentModel.Properties {
+			if _, ok := newModel.Properties[key]; !ok {
+				removedKeys = append(removedKeys, key)
+			}
+		}
+	}
+
+	changedProperties := map[string]string{}
+	for key, value := range newModel.Properties {
+		if currentModel == nil || newModel.Authoritative.ValueBool() != currentModel.Authoritative.ValueBool() {
+			changedProperties[key] = value
+		} else if currentValue, ok := currentModel.Properties[key]; !ok || currentValue != value {
+			changedProperties[key] = value
+		}
+	}
+
+	return r.client.SetProjectProperties(ctx, newModel.ProjectId, changedProperties, removedKeys)
+}
+
+// Validators
+
+type projectPropertyNameValidator struct{}
+
+func (v projectPropertyNameValidator) Description(_ context.Context) string {
+	return "Property name is reserved"
+}
+
+func (v projectPropertyNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectPropertyNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := req.ConfigValue.ValueString()
+	if strings.TrimSpace(name) == "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Property name is empty", "The name of a project property must not be empty.")
+		return
+	}
+	if isSystemProjectProperty(name) {
+		resp.Diagnostics.AddAttributeError(req.Path, v.Description(ctx), fmt.Sprintf("The property '%s' is set by Azure DevOps, the names starting with '%s' cannot be managed.", name, core.ProjectPropertySystemPrefix))
+	}
+}
@@ -0,0 +1,106 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"golang.org/x/exp/slices"
+	"testing"
+)
+
+func TestAccProjectPropertiesResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	projectId := ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPropertiesConfig(server.URL, false, `
+    CostCenter = "CC-1234"
+    Owner      = "platform-team@contoso.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("azuredevops_project.test", "id", func(value string) error {
+						projectId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("azuredevops_project_properties.test", "properties.%", "2"),
+					resource.TestCheckResourceAttr("azuredevops_project_properties.test", "properties.CostCenter", "CC-1234"),
+					resource.TestCheckResourceAttr("data.azuredevops_project_properties.test", "properties.System.SourceControlGitEnabled", "true"),
+					testAccCheckProjectProperties(server, "CostCenter", "Owner"),
+				),
+			},
+			{
+				// The properties that are not declared are only deleted when authoritative
+				PreConfig: func() {
+					err := acctest.NewClient(server).CoreClient.SetProjectProperties(context.Background(), projectId, map[string]string{"External": "unmanaged"}, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProjectPropertiesConfig(server.URL, false, `
+    CostCenter = "CC-5678"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_project_properties.test", "properties.%", "1"),
+					resource.TestCheckResourceAttr("azuredevops_project_properties.test", "properties.CostCenter", "CC-5678"),
+					testAccCheckProjectProperties(server, "CostCenter", "External"),
+				),
+			},
+			{
+				Config: testAccProjectPropertiesConfig(server.URL, true, `
+    CostCenter = "CC-5678"`),
+				Check: testAccCheckProjectProperties(server, "CostCenter"),
+			},
+			{
+				ResourceName:                         "azuredevops_project_properties.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+				ImportStateVerifyIgnore:              []string{"authoritative"},
+			},
+		},
+	})
+}
+
+// testAccCheckProjectProperties checks the names of the properties of the project which are not set by Azure DevOps.
+func testAccCheckProjectProperties(server *fakeserver.Server, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		projectId := s.RootModule().Resources["azuredevops_project.test"].Primary.ID
+		properties, err := acctest.NewClient(server).CoreClient.GetProjectProperties(context.Background(), projectId, nil)
+		if err != nil {
+			return err
+		}
+
+		var actualNames []string
+		for _, property := range *properties {
+			if name := *property.Name; !slices.Contains([]string{"System.CurrentProcessTemplateId", "System.SourceControlGitEnabled"}, name) {
+				actualNames = append(actualNames, name)
+			}
+		}
+		slices.Sort(actualNames)
+		if !slices.Equal(actualNames, names) {
+			return fmt.Errorf("expected the properties %v, got %v", names, actualNames)
+		}
+		return nil
+	}
+}
+
+func testAccProjectPropertiesConfig(organizationUrl string, authoritative bool, properties string) string {
+	return testAccProjectConfig(organizationUrl, "Managed by Terraform") + fmt.Sprintf(`
+resource "azuredevops_project_properties" "test" {
+  authoritative = %t
+  project_id    = azuredevops_project.test.id
+  properties = {%s
+  }
+}
+
+data "azuredevops_project_properties" "test" {
+  include_system = true
+  project_id     = azuredevops_project_properties.test.project_id
+}
+`, authoritative, properties)
+}
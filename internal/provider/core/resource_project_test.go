@@ -0,0 +1,237 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"regexp"
+	"testing"
+)
+
+func TestAccProjectResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroyed(server, "Sandbox"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig(server.URL, "Managed by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("azuredevops_project.test", "id"),
+					resource.TestCheckResourceAttr("azuredevops_project.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("azuredevops_project.test", "name", "Sandbox"),
+					resource.TestCheckResourceAttrPair("azuredevops_project.test", "process_template_id", "data.azuredevops_process.agile", "id"),
+				),
+			},
+			{
+				Config: testAccProjectConfig(server.URL, "Updated by Terraform"),
+				Check:  resource.TestCheckResourceAttr("azuredevops_project.test", "description", "Updated by Terraform"),
+			},
+			{
+				ResourceName:            "azuredevops_project.test",
+				ImportState:             true,
+				ImportStateId:           "Sandbox",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "permanently_delete", "restore_deleted", "timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_UpdateInPlace(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	var projectId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroyed(server, "Sandbox"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVisibilityConfig(server.URL, "private", true),
+				Check: resource.TestCheckResourceAttrWith("azuredevops_project.test", "id", func(value string) error {
+					projectId = value
+					return nil
+				}),
+			},
+			{
+				Config: testAccProjectVisibilityConfig(server.URL, "public", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_project.test", "visibility", "public"),
+					resource.TestCheckResourceAttrWith("azuredevops_project.test", "id", func(value string) error {
+						if value != projectId {
+							return fmt.Errorf("the project was replaced, its id changed from '%s' to '%s'", projectId, value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config:      testAccProjectVisibilityConfig(server.URL, "public", true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Project 'Sandbox' is protected against deletion`),
+			},
+			{
+				Config: testAccProjectVisibilityConfig(server.URL, "public", false),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_FailedProcessMigration(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroyed(server, "Sandbox"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectProcessConfig(server.URL, "Agile", false),
+			},
+			{
+				// Azure DevOps refuses to migrate a project between two system processes
+				Config:      testAccProjectProcessConfig(server.URL, "Scrum", true),
+				ExpectError: regexp.MustCompile(`Failed to migrate project to process`),
+			},
+			{
+				// The other changes were saved along with the failed migration, so only the process is left to change
+				Config:   testAccProjectProcessConfig(server.URL, "Agile", true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_RestoreDeleted(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	var projectId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroyed(server, "Sandbox"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRestoreConfig(server.URL, "Managed by Terraform"),
+				Check: resource.TestCheckResourceAttrWith("azuredevops_project.test", "id", func(value string) error {
+					projectId = value
+					return nil
+				}),
+			},
+			{
+				// The project is moved to the recycle bin
+				Config: acctest.ProviderConfig(server.URL),
+			},
+			{
+				Config: testAccProjectRestoreConfig(server.URL, "Restored by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_project.test", "description", "Restored by Terraform"),
+					resource.TestCheckResourceAttrWith("azuredevops_project.test", "id", func(value string) error {
+						if value != projectId {
+							return fmt.Errorf("expected the restored project '%s', got '%s'", projectId, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_NameConflict(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	if _, err := acctest.NewClient(server).CoreClient.CreateProject(context.Background(), "Sandbox", "", "private", "adcc42ab-9882-485e-a3ed-7678f01f66bc", "Git"); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfig(server.URL, "Managed by Terraform"),
+				ExpectError: regexp.MustCompile(`Project 'Sandbox' already exists`),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectDestroyed(server *fakeserver.Server, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		_, err := acctest.NewClient(server).CoreClient.GetProject(context.Background(), name)
+		if err == nil {
+			return fmt.Errorf("project '%s' still exists", name)
+		}
+		if !utils.ResponseWasNotFound(err) {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccProjectConfig(organizationUrl string, description string) string {
+	return acctest.ProviderConfig(organizationUrl) + fmt.Sprintf(`
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_project" "test" {
+  description         = %q
+  name                = "Sandbox"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Git"
+  visibility          = "private"
+}
+`, description)
+}
+
+func testAccProjectVisibilityConfig(organizationUrl string, visibility string, deletionProtection bool) string {
+	return acctest.ProviderConfig(organizationUrl) + fmt.Sprintf(`
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_project" "test" {
+  deletion_protection = %t
+  name                = "Sandbox"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Git"
+  visibility          = %q
+}
+`, deletionProtection, visibility)
+}
+
+func testAccProjectProcessConfig(organizationUrl string, process string, permanentlyDelete bool) string {
+	return acctest.ProviderConfig(organizationUrl) + fmt.Sprintf(`
+data "azuredevops_process" "test" {
+  name = %q
+}
+
+resource "azuredevops_project" "test" {
+  name                = "Sandbox"
+  permanently_delete  = %t
+  process_template_id = data.azuredevops_process.test.id
+  version_control     = "Git"
+  visibility          = "private"
+}
+`, process, permanentlyDelete)
+}
+
+func testAccProjectRestoreConfig(organizationUrl string, description string) string {
+	return acctest.ProviderConfig(organizationUrl) + fmt.Sprintf(`
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_project" "test" {
+  description         = %q
+  name                = "Sandbox"
+  process_template_id = data.azuredevops_process.agile.id
+  restore_deleted     = true
+  version_control     = "Git"
+  visibility          = "private"
+}
+`, description)
+}
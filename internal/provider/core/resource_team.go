@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,6 +17,7 @@ import (
 )
 
 var _ resource.Resource = &TeamResource{}
+var _ resource.ResourceWithImportState = &TeamResource{}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -83,6 +86,10 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	description := utils.IfThenElse[*string](model.Description != nil, model.Description, utils.EmptyString)
 	team, err := r.client.CreateTeam(ctx, model.ProjectId, model.Name, *description)
+	if utils.ResponseWasConflict(err) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), fmt.Sprintf("Team '%s' already exists", model.Name), fmt.Sprintf("%s\n\nImport the existing team or choose another name.", err.Error()))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Team", err.Error())
 		return
@@ -149,3 +156,29 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		resp.Diagnostics.AddError("Failed to delete Team", err.Error())
 	}
 }
+
+func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/teamId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.client.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	team, err := r.client.GetTeam(ctx, projectId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find team '%s'", parts[1]), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &TeamResourceModel{
+		Description: team.Description,
+		Id:          types.StringValue(team.Id.String()),
+		ProjectId:   projectId,
+	})...)
+}
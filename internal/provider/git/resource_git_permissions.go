@@ -2,6 +2,7 @@ package git
 
 import (
 	"context"
+	"fmt"
 	"github.com/ahmetb/go-linq/v3"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -10,9 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
 	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 )
 
@@ -36,12 +39,14 @@ const (
 )
 
 var _ resource.Resource = &GitPermissionsResource{}
+var _ resource.ResourceWithImportState = &GitPermissionsResource{}
 
 func NewGitPermissionsResource() resource.Resource {
 	return &GitPermissionsResource{}
 }
 
 type GitPermissionsResource struct {
+	coreClient     *core.Client
 	graphClient    *graph.Client
 	securityClient *clientSecurity.Client
 }
@@ -236,6 +241,7 @@ func (r *GitPermissionsResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.graphClient = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
 	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
 }
@@ -318,6 +324,37 @@ func (r *GitPermissionsResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+func (r *GitPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/principalName/repositoryId", "projectId/principalName")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	model := &GitPermissionsResourceModel{ProjectId: projectId}
+	if len(parts) == 3 {
+		model.Id = types.StringValue(parts[2])
+	}
+
+	token := r.securityClient.GetRepositoryToken(projectId, model.Id.ValueString())
+	permissions, err := security.ImportPrincipalPermissions(ctx, clientSecurity.NamespaceIdGitRepositories, token, parts[1], r.securityClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve access control lists", err.Error())
+		return
+	}
+
+	model.PrincipalDescriptor = types.StringValue(permissions.PrincipalDescriptor)
+	model.PrincipalName = permissions.PrincipalName
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
 // Private Methods
 
 func (r *GitPermissionsResource) getPermissions(model *GitPermissionsResourceModel) *security.PrincipalPermissions {
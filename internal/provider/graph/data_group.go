@@ -94,20 +94,14 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	groups, err := d.client.GetGroups(ctx, model.ProjectId, "")
+	group, err := d.client.FindGroup(ctx, model.ProjectId, func(group *graph.GraphGroup) bool {
+		return strings.EqualFold(*group.DisplayName, model.DisplayName)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve groups", err.Error())
 		return
 	}
 
-	var group *graph.GraphGroup
-	for _, g := range *groups {
-		if strings.EqualFold(*g.DisplayName, model.DisplayName) {
-			group = &g
-			break
-		}
-	}
-
 	if group == nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Group with name '%s' not found", model.DisplayName), "")
 		return
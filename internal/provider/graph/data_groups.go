@@ -96,7 +96,7 @@ func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	groups, err := d.client.GetGroups(ctx, model.ProjectId, "")
+	groups, err := d.client.GetGroups(ctx, model.ProjectId)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve groups", err.Error())
 		return
@@ -89,20 +89,14 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	users, err := d.client.GetUsers(ctx, model.ProjectId, "")
+	user, err := d.client.FindUser(ctx, model.ProjectId, func(user *graph.GraphUser) bool {
+		return strings.EqualFold(*user.MailAddress, model.MailAddress)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve users", err.Error())
 		return
 	}
 
-	var user *graph.GraphUser
-	for _, u := range *users {
-		if strings.EqualFold(*u.MailAddress, model.MailAddress) {
-			user = &u
-			break
-		}
-	}
-
 	if user == nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("User with mail address '%s' not found", model.MailAddress), "")
 		return
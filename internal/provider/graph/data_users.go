@@ -92,7 +92,7 @@ func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	groups, err := d.client.GetUsers(ctx, model.ProjectId, "")
+	groups, err := d.client.GetUsers(ctx, model.ProjectId)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to retrieve users", err.Error())
 		return
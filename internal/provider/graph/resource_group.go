@@ -3,6 +3,7 @@ package graph
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -10,19 +11,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"strings"
 )
 
 var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
 }
 
 type GroupResource struct {
-	client *graph.Client
+	client     *graph.Client
+	coreClient *core.Client
 }
 
 type GroupResourceModel struct {
@@ -99,6 +104,7 @@ func (r *GroupResource) Configure(_ context.Context, req resource.ConfigureReque
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -110,6 +116,10 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	group, err := r.client.CreateGroup(ctx, model.ProjectId, model.DisplayName, model.Description.ValueString())
+	if utils.ResponseWasConflict(err) {
+		resp.Diagnostics.AddAttributeError(path.Root("display_name"), fmt.Sprintf("Group '%s' already exists", model.DisplayName), fmt.Sprintf("%s\n\nImport the existing group or choose another name.", err.Error()))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create group", err.Error())
 		return
@@ -181,3 +191,44 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		resp.Diagnostics.AddError(fmt.Sprintf("Group with descriptor '%s' failed to delete", model.Descriptor.ValueString()), err.Error())
 	}
 }
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	descriptor := req.ID
+	if strings.Contains(req.ID, "/") {
+		parts, err := utils.ParseImportId(req.ID, "projectId/displayName")
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+			return
+		}
+
+		projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+			return
+		}
+
+		groupDescriptor, err := r.client.GetGroupDescriptor(ctx, projectId, parts[1])
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to find group '%s'", parts[1]), err.Error())
+			return
+		}
+		descriptor = *groupDescriptor
+	}
+
+	group, err := r.client.GetGroup(ctx, descriptor)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Group with descriptor '%s' not found", descriptor), err.Error())
+		return
+	}
+
+	if group.Domain == nil || !strings.HasPrefix(*group.Domain, graph.GroupDomainProjectPrefix) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Group with descriptor '%s' is not a project group", descriptor), "")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &GroupResourceModel{
+		Descriptor:  types.StringValue(descriptor),
+		DisplayName: *group.DisplayName,
+		ProjectId:   strings.TrimPrefix(*group.Domain, graph.GroupDomainProjectPrefix),
+	})...)
+}
@@ -3,37 +3,46 @@ package graph
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
+	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/security"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 )
 
 var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
 
 func NewGroupMembershipResource() resource.Resource {
 	return &GroupMembershipResource{}
 }
 
 type GroupMembershipResource struct {
-	client *graph.Client
+	client         *graph.Client
+	coreClient     *core.Client
+	securityClient *clientSecurity.Client
 }
 
 type GroupMembershipResourceModel struct {
-	DisplayName string   `tfsdk:"display_name"`
-	Members     []string `tfsdk:"members"`
-	ProjectId   string   `tfsdk:"project_id"`
+	DisplayName string         `tfsdk:"display_name"`
+	Members     []string       `tfsdk:"members"`
+	ProjectId   string         `tfsdk:"project_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *GroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_group_membership"
 }
 
-func (r *GroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *GroupMembershipResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage group membership within an Azure DevOps project.",
 		Attributes: map[string]schema.Attribute{
@@ -57,6 +66,9 @@ func (r *GroupMembershipResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -66,6 +78,8 @@ func (r *GroupMembershipResource) Configure(_ context.Context, req resource.Conf
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
 }
 
 func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -76,7 +90,13 @@ func (r *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	_, err := r.client.CreateGroupMemberships(ctx, model.ProjectId, model.DisplayName, model.Members)
+	timeout, diags := model.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.CreateGroupMemberships(ctx, model.ProjectId, model.DisplayName, model.Members, timeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create group memberships", err.Error())
 		return
@@ -93,6 +113,15 @@ func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	timeout, diags := model.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	_, err := r.client.GetGroupMemberships(ctx, model.ProjectId, model.DisplayName)
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
@@ -115,7 +144,13 @@ func (r *GroupMembershipResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	_, err := r.client.UpdateGroupMemberships(ctx, model.ProjectId, model.DisplayName, model.Members)
+	timeout, diags := model.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateGroupMemberships(ctx, model.ProjectId, model.DisplayName, model.Members, timeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update group memberships", err.Error())
 		return
@@ -132,8 +167,72 @@ func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	timeout, diags := model.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	err := r.client.DeleteGroupMemberships(ctx, model.ProjectId, model.DisplayName)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete memberships for group '%s' in project '%s'", model.DisplayName, model.ProjectId), err.Error())
 	}
 }
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/displayName")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	memberships, err := r.client.GetGroupMemberships(ctx, projectId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve memberships for group '%s' in project '%s'", parts[1], projectId), err.Error())
+		return
+	}
+
+	var memberDescriptors []string
+	for _, membership := range *memberships {
+		memberDescriptors = append(memberDescriptors, *membership.MemberDescriptor)
+	}
+
+	identities, err := r.securityClient.GetIdentitiesBySubjectDescriptors(ctx, memberDescriptors)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the identities of the members of group '%s'", parts[1]), err.Error())
+		return
+	}
+
+	members := []string{}
+	for _, membership := range *memberships {
+		identity, ok := identities[*membership.MemberDescriptor]
+		if !ok {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve identity '%s'", *membership.MemberDescriptor), "Identity not found")
+			return
+		}
+
+		name, err := security.GetPrincipalName(identity)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve identity '%s'", *membership.MemberDescriptor), err.Error())
+			return
+		}
+		members = append(members, name)
+	}
+
+	model := &GroupMembershipResourceModel{
+		DisplayName: parts[1],
+		Members:     members,
+		ProjectId:   projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
@@ -0,0 +1,78 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"strings"
+	"testing"
+)
+
+func TestAccGroupMembershipResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	server.AddUser("Jane Doe", "jane.doe@contoso.com")
+	server.AddUser("John Doe", "john.doe@contoso.com")
+	// Entra ID group never seen by the organization, materialized when added as member
+	server.AddEntraGroup("Platform Team", "platform@contoso.com")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig(server.URL, `"jane.doe@contoso.com", "platform@contoso.com", "[Sandbox]\\Readers"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_group_membership.test", "members.#", "3"),
+					testAccCheckGroupMembers(server, "Developers", 3),
+				),
+			},
+			{
+				Config: testAccGroupMembershipConfig(server.URL, `"john.doe@contoso.com", "platform@contoso.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_group_membership.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemAttr("azuredevops_group_membership.test", "members.*", "john.doe@contoso.com"),
+					testAccCheckGroupMembers(server, "Developers", 2),
+				),
+			},
+			{
+				ResourceName:                         "azuredevops_group_membership.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/Developers",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "display_name",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			{
+				// Removing the membership resource removes all the members of the group
+				Config: testAccGroupConfig(server.URL, "Developers", "Description of the Developers group"),
+				Check:  testAccCheckGroupMembers(server, "Developers", 0),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembers(server *fakeserver.Server, groupName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		projectId := s.RootModule().Resources["azuredevops_project.test"].Primary.ID
+		memberships, err := acctest.NewClient(server).GraphClient.GetGroupMemberships(context.Background(), projectId, groupName)
+		if err != nil {
+			return err
+		}
+		if len(*memberships) != count {
+			return fmt.Errorf("expected %d members in group '%s', got %d", count, groupName, len(*memberships))
+		}
+		return nil
+	}
+}
+
+func testAccGroupMembershipConfig(organizationUrl string, members string) string {
+	return testAccGroupConfig(organizationUrl, "Developers", "Description of the Developers group") + fmt.Sprintf(`
+resource "azuredevops_group_membership" "test" {
+  display_name = azuredevops_group.test.display_name
+  members      = [%s]
+  project_id   = azuredevops_project.test.id
+}
+`, strings.ReplaceAll(members, `\\`, `\\`))
+}
@@ -0,0 +1,89 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"testing"
+)
+
+func TestAccGroupResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig(server.URL, "Developers", "Description of the Developers group"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("azuredevops_group.test", "descriptor"),
+					resource.TestCheckResourceAttr("azuredevops_group.test", "description", "Description of the Developers group"),
+					resource.TestCheckResourceAttr("azuredevops_group.test", "display_name", "Developers"),
+					resource.TestCheckResourceAttr("azuredevops_group.test", "name", "[Sandbox]\\Developers"),
+				),
+			},
+			{
+				Config: testAccGroupConfig(server.URL, "Developers", "Updated description"),
+				Check:  resource.TestCheckResourceAttr("azuredevops_group.test", "description", "Updated description"),
+			},
+			{
+				ResourceName:                         "azuredevops_group.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/Developers",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "descriptor",
+			},
+		},
+	})
+}
+
+func testAccCheckGroupDestroyed(server *fakeserver.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.NewClient(server)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "azuredevops_group" {
+				continue
+			}
+
+			_, err := client.GraphClient.GetGroup(context.Background(), rs.Primary.Attributes["descriptor"])
+			if err == nil {
+				return fmt.Errorf("group '%s' still exists", rs.Primary.Attributes["display_name"])
+			}
+			if !utils.ResponseWasNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGroupConfig(organizationUrl string, displayName string, description string) string {
+	return testAccProjectConfig(organizationUrl) + fmt.Sprintf(`
+resource "azuredevops_group" "test" {
+  description  = %q
+  display_name = %q
+  project_id   = azuredevops_project.test.id
+}
+`, description, displayName)
+}
+
+// testAccProjectConfig returns the configuration of the project holding the groups.
+func testAccProjectConfig(organizationUrl string) string {
+	return acctest.ProviderConfig(organizationUrl) + `
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_project" "test" {
+  name                = "Sandbox"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Git"
+  visibility          = "private"
+}
+`
+}
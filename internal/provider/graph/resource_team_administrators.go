@@ -0,0 +1,350 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
+	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &TeamAdministratorsResource{}
+var _ resource.ResourceWithImportState = &TeamAdministratorsResource{}
+
+func NewTeamAdministratorsResource() resource.Resource {
+	return &TeamAdministratorsResource{}
+}
+
+type TeamAdministratorsResource struct {
+	client         *graph.Client
+	coreClient     *core.Client
+	securityClient *clientSecurity.Client
+}
+
+type TeamAdministratorsResourceModel struct {
+	Administrators []string       `tfsdk:"administrators"`
+	Authoritative  types.Bool     `tfsdk:"authoritative"`
+	ProjectId      string         `tfsdk:"project_id"`
+	TeamId         string         `tfsdk:"team_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TeamAdministratorsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_administrators"
+}
+
+func (r *TeamAdministratorsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the administrators of a team within an Azure DevOps project, who can manage the members and the settings of the team.",
+		Attributes: map[string]schema.Attribute{
+			"administrators": schema.SetAttribute{
+				MarkdownDescription: "A list of users or groups that will become administrators of the team.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to remove the administrators of the team that are not declared in `administrators`. Otherwise, only the declared administrators are managed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *TeamAdministratorsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
+}
+
+func (r *TeamAdministratorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *TeamAdministratorsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.updateTeamAdministrators(ctx, nil, model)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to add team administrators", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamAdministratorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *TeamAdministratorsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := r.coreClient.GetTeam(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the team", err.Error())
+		return
+	}
+
+	descriptors, err := r.getAdministratorDescriptors(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the administrators of team '%s'", model.TeamId), err.Error())
+		return
+	}
+
+	identities, err := r.securityClient.GetIdentitiesByDescriptors(ctx, descriptors)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the identities of the administrators of team '%s'", model.TeamId), err.Error())
+		return
+	}
+
+	// All the administrators are read when authoritative or imported, only the managed ones otherwise
+	model.Administrators, err = readPrincipalNames(identities, model.Administrators, model.Authoritative.ValueBool() || model.Administrators == nil)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the identities of the administrators of team '%s'", model.TeamId), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamAdministratorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var currentModel *TeamAdministratorsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentModel)...)
+
+	var newModel *TeamAdministratorsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &newModel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := newModel.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.updateTeamAdministrators(ctx, currentModel, newModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update team administrators", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
+}
+
+func (r *TeamAdministratorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *TeamAdministratorsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	descriptors, err := r.getIdentityDescriptors(ctx, model.Administrators)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to resolve team administrators", err.Error())
+		return
+	}
+
+	if len(descriptors) == 0 {
+		return
+	}
+
+	err = r.securityClient.RemoveAccessControlEntries(ctx, clientSecurity.NamespaceIdIdentity, r.securityClient.GetTeamToken(model.ProjectId, model.TeamId), descriptors)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete the administrators of team '%s'", model.TeamId), err.Error())
+	}
+}
+
+func (r *TeamAdministratorsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, teamId, err := importTeam(ctx, req.ID, r.coreClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to import team administrators", err.Error())
+		return
+	}
+
+	model := &TeamAdministratorsResourceModel{
+		Authoritative: types.BoolValue(false),
+		ProjectId:     projectId,
+		TeamId:        teamId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Private Methods
+
+// getAdministratorDescriptors returns the identity descriptors granted the permissions of the administrators on a team.
+func (r *TeamAdministratorsResource) getAdministratorDescriptors(ctx context.Context, projectId string, teamId string) ([]string, error) {
+	acls, err := r.securityClient.GetAccessControlLists(ctx, clientSecurity.NamespaceIdIdentity, r.securityClient.GetTeamToken(projectId, teamId))
+	if err != nil {
+		return nil, err
+	}
+
+	descriptors := []string{}
+	if acls.Value == nil || len(*acls.Value) == 0 {
+		return descriptors, nil
+	}
+
+	for descriptor, ace := range *(*acls.Value)[0].AcesDictionary {
+		if ace.Allow != nil && *ace.Allow&clientSecurity.IdentityPermissionsTeamAdministrator == clientSecurity.IdentityPermissionsTeamAdministrator {
+			descriptors = append(descriptors, descriptor)
+		}
+	}
+	return descriptors, nil
+}
+
+// getIdentityDescriptors resolves users and groups to the identity descriptors used by the access control entries.
+func (r *TeamAdministratorsResource) getIdentityDescriptors(ctx context.Context, names []string) ([]string, error) {
+	subjectDescriptors, err := r.client.GetMemberDescriptors(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := r.securityClient.GetIdentitiesBySubjectDescriptors(ctx, *subjectDescriptors)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptors := []string{}
+	for _, subjectDescriptor := range *subjectDescriptors {
+		identity, ok := identities[subjectDescriptor]
+		if !ok {
+			return nil, fmt.Errorf("identity '%s' not found", subjectDescriptor)
+		}
+		descriptors = append(descriptors, *identity.Descriptor)
+	}
+	return descriptors, nil
+}
+
+func (r *TeamAdministratorsResource) updateTeamAdministrators(ctx context.Context, currentModel *TeamAdministratorsResourceModel, newModel *TeamAdministratorsResourceModel) error {
+	token := r.securityClient.GetTeamToken(newModel.ProjectId, newModel.TeamId)
+	descriptors, err := r.getIdentityDescriptors(ctx, newModel.Administrators)
+	if err != nil {
+		return err
+	}
+
+	currentDescriptors, err := r.getAdministratorDescriptors(ctx, newModel.ProjectId, newModel.TeamId)
+	if err != nil {
+		return err
+	}
+
+	// The administrators removed from the configuration are removed from the team, and all the undeclared
+	// administrators when authoritative. The access control entries may not use the case of the identities.
+	var removedDescriptors []string
+	if newModel.Authoritative.ValueBool() {
+		removedDescriptors = *utils.DifferenceFold(&currentDescriptors, &descriptors)
+	} else if currentModel != nil {
+		previousDescriptors, err := r.getIdentityDescriptors(ctx, currentModel.Administrators)
+		if err != nil {
+			return err
+		}
+
+		for _, descriptor := range currentDescriptors {
+			if utils.ContainsFold(previousDescriptors, descriptor) && !utils.ContainsFold(descriptors, descriptor) {
+				removedDescriptors = append(removedDescriptors, descriptor)
+			}
+		}
+	}
+
+	if len(removedDescriptors) > 0 {
+		err = r.securityClient.RemoveAccessControlEntries(ctx, clientSecurity.NamespaceIdIdentity, token, removedDescriptors)
+		if err != nil {
+			return err
+		}
+	}
+
+	var aces []clientSecurity.AccessControlEntry
+	for _, descriptor := range *utils.DifferenceFold(&descriptors, &currentDescriptors) {
+		aces = append(aces, clientSecurity.AccessControlEntry{
+			Allow:      utils.Int(clientSecurity.IdentityPermissionsTeamAdministrator),
+			Deny:       utils.Int(0),
+			Descriptor: utils.String(descriptor),
+		})
+	}
+
+	if len(aces) == 0 {
+		return nil
+	}
+
+	return r.securityClient.SetAccessControlEntries(ctx, clientSecurity.NamespaceIdIdentity, token, &aces)
+}
@@ -0,0 +1,176 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"strings"
+	"testing"
+)
+
+func TestAccTeamAdministratorsResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	server.AddUser("Jane Doe", "jane.doe@contoso.com")
+	server.AddUser("John Doe", "john.doe@contoso.com")
+	projectId, teamId, janeDescriptor := "", "", ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamAdministratorsConfig(server.URL, false, `"jane.doe@contoso.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("azuredevops_team.test", "project_id", func(value string) error {
+						projectId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("azuredevops_team.test", "id", func(value string) error {
+						teamId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("azuredevops_team_administrators.test", "administrators.#", "1"),
+					testAccCheckTeamAdministrators(server, &janeDescriptor, "jane.doe@contoso.com"),
+				),
+			},
+			{
+				// The access control entries may not use the case of the identities, the removed administrators are
+				// still found
+				PreConfig: func() {
+					descriptors, err := getTeamAdministratorDescriptors(server, projectId, teamId)
+					if err == nil {
+						err = setTeamAdministrators(server, projectId, teamId, descriptors, strings.ToLower)
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccTeamAdministratorsConfig(server.URL, false, `"john.doe@contoso.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_administrators.test", "administrators.#", "1"),
+					resource.TestCheckTypeSetElemAttr("azuredevops_team_administrators.test", "administrators.*", "john.doe@contoso.com"),
+					testAccCheckTeamAdministrators(server, nil, "john.doe@contoso.com"),
+				),
+			},
+			{
+				// The undeclared administrators are only removed when authoritative
+				PreConfig: func() {
+					err := setTeamAdministrators(server, projectId, teamId, []string{janeDescriptor}, strings.ToUpper)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccTeamAdministratorsConfig(server.URL, true, `"john.doe@contoso.com"`),
+				Check:  testAccCheckTeamAdministrators(server, nil, "john.doe@contoso.com"),
+			},
+			{
+				ResourceName:                         "azuredevops_team_administrators.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/Developers Team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+				ImportStateVerifyIgnore:              []string{"authoritative", "timeouts"},
+			},
+			{
+				// Removing the resource removes the administrators of the team
+				Config: testAccTeamConfig(server.URL),
+				Check:  testAccCheckTeamAdministrators(server, nil),
+			},
+		},
+	})
+}
+
+// getTeamAdministratorDescriptors returns the descriptors of the access control entries of the administrators of a team.
+func getTeamAdministratorDescriptors(server *fakeserver.Server, projectId string, teamId string) ([]string, error) {
+	client := acctest.NewClient(server).SecurityClient
+	acls, err := client.GetAccessControlLists(context.Background(), security.NamespaceIdIdentity, client.GetTeamToken(projectId, teamId))
+	if err != nil {
+		return nil, err
+	}
+
+	var descriptors []string
+	for _, acl := range *acls.Value {
+		for descriptor, ace := range *acl.AcesDictionary {
+			if *ace.Allow&security.IdentityPermissionsTeamAdministrator != 0 {
+				descriptors = append(descriptors, descriptor)
+			}
+		}
+	}
+	return descriptors, nil
+}
+
+// setTeamAdministrators grants the permissions of the administrators of a team to descriptors, after changing their
+// case with transform.
+func setTeamAdministrators(server *fakeserver.Server, projectId string, teamId string, descriptors []string, transform func(string) string) error {
+	client := acctest.NewClient(server).SecurityClient
+	token := client.GetTeamToken(projectId, teamId)
+	err := client.RemoveAccessControlEntries(context.Background(), security.NamespaceIdIdentity, token, descriptors)
+	if err != nil {
+		return err
+	}
+
+	var aces []security.AccessControlEntry
+	for _, descriptor := range descriptors {
+		aces = append(aces, security.AccessControlEntry{
+			Allow:      utils.Int(security.IdentityPermissionsTeamAdministrator),
+			Deny:       utils.Int(0),
+			Descriptor: utils.String(transform(descriptor)),
+		})
+	}
+	return client.SetAccessControlEntries(context.Background(), security.NamespaceIdIdentity, token, &aces)
+}
+
+// testAccCheckTeamAdministrators checks the mail addresses of the administrators of the team, whose access control
+// entries are named after them. The descriptor of the first administrator is stored in firstDescriptor when not nil.
+func testAccCheckTeamAdministrators(server *fakeserver.Server, firstDescriptor *string, mails ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		team := s.RootModule().Resources["azuredevops_team.test"].Primary
+		descriptors, err := getTeamAdministratorDescriptors(server, team.Attributes["project_id"], team.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(descriptors) != len(mails) {
+			return fmt.Errorf("expected the administrators %v, got %v", mails, descriptors)
+		}
+		for i, mail := range mails {
+			found := false
+			for _, descriptor := range descriptors {
+				if strings.HasSuffix(strings.ToLower(descriptor), "\\"+mail) {
+					found = true
+					if i == 0 && firstDescriptor != nil {
+						*firstDescriptor = descriptor
+					}
+				}
+			}
+			if !found {
+				return fmt.Errorf("expected the administrators %v, got %v", mails, descriptors)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccTeamConfig(organizationUrl string) string {
+	return testAccProjectConfig(organizationUrl) + `
+resource "azuredevops_team" "test" {
+  name       = "Developers Team"
+  project_id = azuredevops_project.test.id
+}
+`
+}
+
+func testAccTeamAdministratorsConfig(organizationUrl string, authoritative bool, administrators string) string {
+	return testAccTeamConfig(organizationUrl) + fmt.Sprintf(`
+resource "azuredevops_team_administrators" "test" {
+  administrators = [%s]
+  authoritative  = %t
+  project_id     = azuredevops_project.test.id
+  team_id        = azuredevops_team.test.id
+}
+`, administrators, authoritative)
+}
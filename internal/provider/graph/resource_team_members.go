@@ -0,0 +1,336 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
+	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"golang.org/x/exp/slices"
+	"strings"
+	"time"
+)
+
+var _ resource.Resource = &TeamMembersResource{}
+var _ resource.ResourceWithImportState = &TeamMembersResource{}
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+type TeamMembersResource struct {
+	client         *graph.Client
+	coreClient     *core.Client
+	securityClient *clientSecurity.Client
+}
+
+type TeamMembersResourceModel struct {
+	Authoritative types.Bool     `tfsdk:"authoritative"`
+	Members       []string       `tfsdk:"members"`
+	ProjectId     string         `tfsdk:"project_id"`
+	TeamId        string         `tfsdk:"team_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TeamMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the members of a team within an Azure DevOps project.",
+		Attributes: map[string]schema.Attribute{
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to remove the members of the team that are not declared in `members`. Otherwise, only the declared members are managed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "A list of users or groups that will become members of the team.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *TeamMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *TeamMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamMembers(ctx, nil, model, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to add team members", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *TeamMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := r.coreClient.GetTeam(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the team", err.Error())
+		return
+	}
+
+	memberships, err := r.client.GetTeamMemberships(ctx, model.TeamId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the members of team '%s'", model.TeamId), err.Error())
+		return
+	}
+
+	var memberDescriptors []string
+	for _, membership := range *memberships {
+		memberDescriptors = append(memberDescriptors, *membership.MemberDescriptor)
+	}
+
+	identities, err := r.securityClient.GetIdentitiesBySubjectDescriptors(ctx, memberDescriptors)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the identities of the members of team '%s'", model.TeamId), err.Error())
+		return
+	}
+
+	// All the members are read when authoritative or imported, only the managed ones otherwise
+	model.Members, err = readPrincipalNames(identities, model.Members, model.Authoritative.ValueBool() || model.Members == nil)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the identities of the members of team '%s'", model.TeamId), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var currentModel *TeamMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentModel)...)
+
+	var newModel *TeamMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &newModel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := newModel.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamMembers(ctx, currentModel, newModel, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update team members", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *TeamMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := model.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	memberDescriptors, err := r.client.GetMemberDescriptors(ctx, model.Members)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to resolve team members", err.Error())
+		return
+	}
+
+	_, err = r.client.UpdateTeamMemberships(ctx, model.TeamId, []string{}, *memberDescriptors, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete the members of team '%s'", model.TeamId), err.Error())
+	}
+}
+
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, teamId, err := importTeam(ctx, req.ID, r.coreClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to import team members", err.Error())
+		return
+	}
+
+	model := &TeamMembersResourceModel{
+		Authoritative: types.BoolValue(false),
+		ProjectId:     projectId,
+		TeamId:        teamId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Private Methods
+
+// importTeam returns the IDs of the project and the team of an import identifier made of their IDs or names.
+func importTeam(ctx context.Context, id string, coreClient *core.Client) (string, string, error) {
+	parts, err := utils.ParseImportId(id, "projectId/teamId")
+	if err != nil {
+		return "", "", err
+	}
+
+	projectId, err := coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("unable to find project '%s': %s", parts[0], err.Error())
+	}
+
+	team, err := coreClient.GetTeam(ctx, projectId, parts[1])
+	if err != nil {
+		return "", "", fmt.Errorf("unable to find team '%s': %s", parts[1], err.Error())
+	}
+
+	return projectId, team.Id.String(), nil
+}
+
+// readPrincipalNames returns the names of identities, keeping the configured names when they match. The identities
+// missing from the configuration are only returned when all is true.
+func readPrincipalNames(identities map[string]*clientSecurity.Identity, configuredNames []string, all bool) ([]string, error) {
+	names := []string{}
+	for _, identity := range identities {
+		name, err := security.GetPrincipalName(identity)
+		if err != nil {
+			return nil, err
+		}
+
+		isConfigured := false
+		for _, configuredName := range configuredNames {
+			if strings.EqualFold(configuredName, name) {
+				names = append(names, configuredName)
+				isConfigured = true
+				break
+			}
+		}
+
+		if !isConfigured && all {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (r *TeamMembersResource) updateTeamMembers(ctx context.Context, currentModel *TeamMembersResourceModel, newModel *TeamMembersResourceModel, timeout time.Duration) error {
+	memberDescriptors, err := r.client.GetMemberDescriptors(ctx, newModel.Members)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := r.client.GetTeamMemberships(ctx, newModel.TeamId)
+	if err != nil {
+		return err
+	}
+
+	var currentDescriptors []string
+	for _, membership := range *memberships {
+		currentDescriptors = append(currentDescriptors, *membership.MemberDescriptor)
+	}
+
+	// The members removed from the configuration are removed from the team, and all the undeclared members when authoritative
+	removedDescriptors := &[]string{}
+	if newModel.Authoritative.ValueBool() {
+		removedDescriptors = utils.Difference(&currentDescriptors, memberDescriptors)
+	} else if currentModel != nil {
+		previousDescriptors, err := r.client.GetMemberDescriptors(ctx, currentModel.Members)
+		if err != nil {
+			return err
+		}
+		for _, descriptor := range *utils.Difference(previousDescriptors, memberDescriptors) {
+			if slices.Contains(currentDescriptors, descriptor) {
+				*removedDescriptors = append(*removedDescriptors, descriptor)
+			}
+		}
+	}
+
+	addedDescriptors := utils.Difference(memberDescriptors, &currentDescriptors)
+	_, err = r.client.UpdateTeamMemberships(ctx, newModel.TeamId, *addedDescriptors, *removedDescriptors, timeout)
+	return err
+}
@@ -0,0 +1,109 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"golang.org/x/exp/slices"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+	alice := server.AddUser("Alice Smith", "alice.smith@contoso.com")
+	jane := server.AddUser("Jane Doe", "jane.doe@contoso.com")
+	john := server.AddUser("John Doe", "john.doe@contoso.com")
+	teamId := ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersConfig(server.URL, false, `"jane.doe@contoso.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("azuredevops_team.test", "id", func(value string) error {
+						teamId = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("azuredevops_team_members.test", "members.#", "1"),
+					testAccCheckTeamMembers(server, jane),
+				),
+			},
+			{
+				// The undeclared members are kept when not authoritative, the removed members are removed
+				PreConfig: func() {
+					_, err := acctest.NewClient(server).GraphClient.UpdateTeamMemberships(context.Background(), teamId, []string{john}, []string{}, time.Minute)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccTeamMembersConfig(server.URL, false, `"alice.smith@contoso.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_members.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("azuredevops_team_members.test", "members.*", "alice.smith@contoso.com"),
+					testAccCheckTeamMembers(server, alice, john),
+				),
+			},
+			{
+				// The undeclared members are removed when authoritative
+				Config: testAccTeamMembersConfig(server.URL, true, `"alice.smith@contoso.com", "jane.doe@contoso.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_members.test", "members.#", "2"),
+					testAccCheckTeamMembers(server, alice, jane),
+				),
+			},
+			{
+				ResourceName:                         "azuredevops_team_members.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/Developers Team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+				ImportStateVerifyIgnore:              []string{"authoritative", "timeouts"},
+			},
+			{
+				// Removing the resource removes the members of the team
+				Config: testAccTeamConfig(server.URL),
+				Check:  testAccCheckTeamMembers(server),
+			},
+		},
+	})
+}
+
+// testAccCheckTeamMembers checks the subject descriptors of the members of the team.
+func testAccCheckTeamMembers(server *fakeserver.Server, descriptors ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		teamId := s.RootModule().Resources["azuredevops_team.test"].Primary.ID
+		memberships, err := acctest.NewClient(server).GraphClient.GetTeamMemberships(context.Background(), teamId)
+		if err != nil {
+			return err
+		}
+
+		var members []string
+		for _, membership := range *memberships {
+			members = append(members, *membership.MemberDescriptor)
+		}
+		sort.Strings(members)
+		expected := append([]string{}, descriptors...)
+		sort.Strings(expected)
+		if !slices.Equal(members, expected) {
+			return fmt.Errorf("expected the members %v, got %v", expected, members)
+		}
+		return nil
+	}
+}
+
+func testAccTeamMembersConfig(organizationUrl string, authoritative bool, members string) string {
+	return testAccTeamConfig(organizationUrl) + fmt.Sprintf(`
+resource "azuredevops_team_members" "test" {
+  authoritative = %t
+  members       = [%s]
+  project_id    = azuredevops_project.test.id
+  team_id       = azuredevops_team.test.id
+}
+`, authoritative, members)
+}
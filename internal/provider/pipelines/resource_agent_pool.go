@@ -2,6 +2,7 @@ package pipelines
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -10,9 +11,11 @@ import (
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"strconv"
 )
 
 var _ resource.Resource = &AgentPoolResource{}
+var _ resource.ResourceWithImportState = &AgentPoolResource{}
 
 func NewAgentPoolResource() resource.Resource {
 	return &AgentPoolResource{}
@@ -143,3 +146,13 @@ func (r *AgentPoolResource) Delete(ctx context.Context, req resource.DeleteReque
 		resp.Diagnostics.AddError("Unable to delete agent pool", err.Error())
 	}
 }
+
+func (r *AgentPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", fmt.Sprintf("'%s' is not a valid agent pool ID", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &AgentPoolResourceModel{Id: types.Int64Value(int64(id))})...)
+}
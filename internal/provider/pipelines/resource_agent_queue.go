@@ -2,6 +2,7 @@ package pipelines
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
@@ -17,13 +19,15 @@ import (
 )
 
 var _ resource.Resource = &AgentQueueResource{}
+var _ resource.ResourceWithImportState = &AgentQueueResource{}
 
 func NewAgentQueueResource() resource.Resource {
 	return &AgentQueueResource{}
 }
 
 type AgentQueueResource struct {
-	client *pipelines.Client
+	client     *pipelines.Client
+	coreClient *core.Client
 }
 
 type AgentQueueResourceModel struct {
@@ -90,6 +94,7 @@ func (r *AgentQueueResource) Configure(_ context.Context, req resource.Configure
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *AgentQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,7 +124,7 @@ func (r *AgentQueueResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	_, err := r.client.GetAgentQueue(ctx, model.ProjectId, int(model.Id.ValueInt64()))
+	queue, err := r.client.GetAgentQueue(ctx, model.ProjectId, int(model.Id.ValueInt64()))
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -142,6 +147,9 @@ func (r *AgentQueueResource) Read(ctx context.Context, req resource.ReadRequest,
 		model.GrantAllPipelines = false
 	}
 
+	model.AgentPoolId = *queue.Pool.Id
+	model.Name = *queue.Name
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -175,3 +183,28 @@ func (r *AgentQueueResource) Delete(ctx context.Context, req resource.DeleteRequ
 		resp.Diagnostics.AddError("Unable to delete queue", err.Error())
 	}
 }
+
+func (r *AgentQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/queueId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", fmt.Sprintf("'%s' is not a valid queue ID", parts[1]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &AgentQueueResourceModel{
+		Id:        types.Int64Value(int64(id)),
+		ProjectId: projectId,
+	})...)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
@@ -17,13 +18,15 @@ import (
 )
 
 var _ resource.Resource = &EnvironmentResource{}
+var _ resource.ResourceWithImportState = &EnvironmentResource{}
 
 func NewEnvironmentResource() resource.Resource {
 	return &EnvironmentResource{}
 }
 
 type EnvironmentResource struct {
-	client *pipelines.Client
+	client     *pipelines.Client
+	coreClient *core.Client
 }
 
 type EnvironmentResourceModel struct {
@@ -78,6 +81,7 @@ func (r *EnvironmentResource) Configure(_ context.Context, req resource.Configur
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -177,3 +181,28 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 		resp.Diagnostics.AddError(fmt.Sprintf("Environment with Id '%d' failed to delete", model.Id.ValueInt64()), err.Error())
 	}
 }
+
+func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/environmentId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", fmt.Sprintf("'%s' is not a valid environment ID", parts[1]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &EnvironmentResourceModel{
+		Id:        types.Int64Value(int64(id)),
+		ProjectId: projectId,
+	})...)
+}
@@ -11,19 +11,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"strconv"
 )
 
 var _ resource.Resource = &EnvironmentKubernetesResource{}
+var _ resource.ResourceWithImportState = &EnvironmentKubernetesResource{}
 
 func NewEnvironmentKubernetesResource() resource.Resource {
 	return &EnvironmentKubernetesResource{}
 }
 
 type EnvironmentKubernetesResource struct {
-	client *pipelines.Client
+	client     *pipelines.Client
+	coreClient *core.Client
 }
 
 type EnvironmentKubernetesResourceModel struct {
@@ -107,6 +111,7 @@ func (r *EnvironmentKubernetesResource) Configure(_ context.Context, req resourc
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *EnvironmentKubernetesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -137,7 +142,7 @@ func (r *EnvironmentKubernetesResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	_, err := r.client.GetEnvironmentResourceKubernetes(ctx, model.ProjectId, model.EnvironmentId, int(model.Id.ValueInt64()))
+	kubernetesResource, err := r.client.GetEnvironmentResourceKubernetes(ctx, model.ProjectId, model.EnvironmentId, int(model.Id.ValueInt64()))
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -148,6 +153,10 @@ func (r *EnvironmentKubernetesResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	model.Name = *kubernetesResource.Name
+	model.Namespace = *kubernetesResource.Namespace
+	model.ServiceEndpointId = *kubernetesResource.ServiceEndpointId
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -168,6 +177,38 @@ func (r *EnvironmentKubernetesResource) Delete(ctx context.Context, req resource
 	}
 }
 
+func (r *EnvironmentKubernetesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/environmentId/resourceId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	environmentId, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", fmt.Sprintf("'%s' is not a valid environment ID", parts[1]))
+		return
+	}
+
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", fmt.Sprintf("'%s' is not a valid resource ID", parts[2]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &EnvironmentKubernetesResourceModel{
+		EnvironmentId: environmentId,
+		Id:            types.Int64Value(int64(id)),
+		ProjectId:     projectId,
+	})...)
+}
+
 // Private Methods
 
 func getEnvironmentResource(model *EnvironmentKubernetesResourceModel) *pipelines.EnvironmentResourceKubernetes {
@@ -2,6 +2,7 @@ package pipelines
 
 import (
 	"context"
+	"fmt"
 	"github.com/ahmetb/go-linq/v3"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -10,10 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
 	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"strconv"
 )
 
 const (
@@ -35,12 +39,14 @@ const (
 )
 
 var _ resource.Resource = &PipelinePermissionsResource{}
+var _ resource.ResourceWithImportState = &PipelinePermissionsResource{}
 
 func NewPipelinePermissionsResource() resource.Resource {
 	return &PipelinePermissionsResource{}
 }
 
 type PipelinePermissionsResource struct {
+	coreClient     *core.Client
 	graphClient    *graph.Client
 	securityClient *clientSecurity.Client
 }
@@ -224,6 +230,7 @@ func (r *PipelinePermissionsResource) Configure(_ context.Context, req resource.
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.graphClient = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
 	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
 }
@@ -306,6 +313,43 @@ func (r *PipelinePermissionsResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+func (r *PipelinePermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/principalName/pipelineId", "projectId/principalName")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	model := &PipelinePermissionsResourceModel{ProjectId: projectId}
+	if len(parts) == 3 {
+		id, err := strconv.Atoi(parts[2])
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import identifier", fmt.Sprintf("'%s' is not a valid pipeline ID", parts[2]))
+			return
+		}
+
+		model.Id = types.Int64Value(int64(id))
+	}
+
+	token := r.securityClient.GetPipelineToken(projectId, int(model.Id.ValueInt64()))
+	permissions, err := security.ImportPrincipalPermissions(ctx, clientSecurity.NamespaceIdBuild, token, parts[1], r.securityClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve access control lists", err.Error())
+		return
+	}
+
+	model.PrincipalDescriptor = types.StringValue(permissions.PrincipalDescriptor)
+	model.PrincipalName = permissions.PrincipalName
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
 // Private Methods
 
 func (r *PipelinePermissionsResource) getPermissions(model *PipelinePermissionsResourceModel) *security.PrincipalPermissions {
@@ -2,24 +2,28 @@ package pipelines
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 )
 
 var _ resource.Resource = &PipelineSettingsResource{}
+var _ resource.ResourceWithImportState = &PipelineSettingsResource{}
 
 func NewPipelineSettingsResource() resource.Resource {
 	return &PipelineSettingsResource{}
 }
 
 type PipelineSettingsResource struct {
-	client *pipelines.Client
+	client     *pipelines.Client
+	coreClient *core.Client
 }
 
 type PipelineSettingsResourceModel struct {
@@ -112,6 +116,7 @@ func (r *PipelineSettingsResource) Configure(_ context.Context, req resource.Con
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *PipelineSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -209,6 +214,16 @@ func (r *PipelineSettingsResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+func (r *PipelineSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, err := r.coreClient.GetProjectId(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &PipelineSettingsResourceModel{ProjectId: projectId})...)
+}
+
 // Private Methods
 
 func (r *PipelineSettingsResource) updatePipelineSettings(ctx context.Context, model *PipelineSettingsResourceModel) error {
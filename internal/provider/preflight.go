@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"strings"
+)
+
+const (
+	envCheckScopes               = "AZDO_CHECK_SCOPES"
+	envSkipCredentialsValidation = "AZDO_SKIP_CREDENTIALS_VALIDATION"
+)
+
+// validateCredentials checks that the organization is reachable and accepts the credentials of the provider, so
+// that bad credentials are reported once at configuration instead of by every resource. The scopes of the credentials
+// are only checked on demand, since the provider does not know which resources are configured and would otherwise
+// warn about areas the configuration never uses.
+func validateCredentials(ctx context.Context, client *clients.AzureDevOpsClient, organizationUrl string, checkScopes bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	connectionData, err := client.CheckCredentials(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, networking.ErrUnauthorized), errors.Is(err, networking.ErrForbidden):
+			diags.AddError("Invalid credentials", fmt.Sprintf("The organization '%s' rejected the credentials of the provider: %s. Check that the credentials are valid, not expired and allowed to access this organization.", organizationUrl, err.Error()))
+		case errors.Is(err, networking.ErrNotFound):
+			diags.AddError("Organization not found", fmt.Sprintf("The organization '%s' does not exist: %s.", organizationUrl, err.Error()))
+		default:
+			diags.AddError("Unable to reach the organization", fmt.Sprintf("The provider cannot connect to the organization '%s': %s. Check the organization url and the network settings of the provider.", organizationUrl, err.Error()))
+		}
+		return diags
+	}
+
+	identity := connectionData.AuthenticatedUser
+	if identity == nil || identity.Id == nil || *identity.Id == uuid.Nil {
+		diags.AddError("Invalid credentials", fmt.Sprintf("The organization '%s' did not authenticate the credentials of the provider, the requests are anonymous. Check that the credentials are valid, not expired and allowed to access this organization.", organizationUrl))
+		return diags
+	}
+
+	displayName := ""
+	if identity.ProviderDisplayName != nil {
+		displayName = *identity.ProviderDisplayName
+	}
+	logger.Info(ctx, "Authenticated to Azure DevOps", map[string]any{
+		"identity_id":      identity.Id.String(),
+		"identity_name":    displayName,
+		"organization_url": organizationUrl,
+	})
+
+	if !checkScopes {
+		return diags
+	}
+
+	if missingScopes := client.CheckScopes(ctx, connectionData); len(missingScopes) > 0 {
+		var areas []string
+		for _, missingScope := range missingScopes {
+			areas = append(areas, fmt.Sprintf("  - %s (%s): %s", missingScope.Area, missingScope.Scope, missingScope.Err.Error()))
+		}
+		diags.AddWarning("Insufficient credentials", fmt.Sprintf("The credentials of '%s' are not allowed to call the following areas of the REST APIs:\n%s\n\nThe resources and data sources of these areas will fail. Grant the missing scopes to the personal access token, or unset 'check_scopes' to skip this check.", displayName, strings.Join(areas, "\n")))
+	}
+
+	return diags
+}
@@ -0,0 +1,101 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"strings"
+)
+
+// findControl returns a control of a group of the layout of a work item type.
+func findControl(layout *processes.FormLayout, groupId string, controlId string) *processes.Control {
+	_, _, group := findGroup(layout, groupId)
+	if group == nil || group.Controls == nil {
+		return nil
+	}
+
+	for i, control := range *group.Controls {
+		if control.Id != nil && strings.EqualFold(*control.Id, controlId) {
+			return &(*group.Controls)[i]
+		}
+	}
+	return nil
+}
+
+// findGroup returns a group of the layout of a work item type, along with its page and its section.
+func findGroup(layout *processes.FormLayout, groupId string) (*processes.Page, *processes.Section, *processes.Group) {
+	if layout.Pages == nil {
+		return nil, nil, nil
+	}
+
+	for i, page := range *layout.Pages {
+		if page.Sections == nil {
+			continue
+		}
+		for j, section := range *page.Sections {
+			if section.Groups == nil {
+				continue
+			}
+			for k, group := range *section.Groups {
+				if group.Id != nil && strings.EqualFold(*group.Id, groupId) {
+					return &(*layout.Pages)[i], &(*page.Sections)[j], &(*section.Groups)[k]
+				}
+			}
+		}
+	}
+	return nil, nil, nil
+}
+
+// findPage returns a page of the layout of a work item type.
+func findPage(layout *processes.FormLayout, pageId string) *processes.Page {
+	if layout.Pages == nil {
+		return nil
+	}
+
+	for i, page := range *layout.Pages {
+		if page.Id != nil && strings.EqualFold(*page.Id, pageId) {
+			return &(*layout.Pages)[i]
+		}
+	}
+	return nil
+}
+
+// getIntPointer returns the value of an optional and computed attribute, nil when it is not known yet.
+func getIntPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	i := int(value.ValueInt64())
+	return &i
+}
+
+// getProcessId resolves the ID of a process from either its ID or its name.
+func getProcessId(ctx context.Context, client *processes.Client, nameOrId string) (string, error) {
+	if _, err := uuid.Parse(nameOrId); err == nil {
+		return nameOrId, nil
+	}
+
+	processList, err := client.GetProcesses(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, process := range *processList {
+		if process.Name != nil && strings.EqualFold(*process.Name, nameOrId) {
+			return process.TypeId.String(), nil
+		}
+	}
+	return "", fmt.Errorf("unable to find process with name '%s'", nameOrId)
+}
+
+// getStringPointer returns the value of an optional and computed attribute, nil when it is not known yet.
+func getStringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueStringPointer()
+}
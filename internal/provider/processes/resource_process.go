@@ -0,0 +1,230 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessResource{}
+var _ resource.ResourceWithImportState = &ProcessResource{}
+
+func NewProcessResource() resource.Resource {
+	return &ProcessResource{}
+}
+
+type ProcessResource struct {
+	client *processes.Client
+}
+
+type ProcessResourceModel struct {
+	Description     *string      `tfsdk:"description"`
+	Id              types.String `tfsdk:"id"`
+	IsDefault       types.Bool   `tfsdk:"is_default"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	Name            string       `tfsdk:"name"`
+	ParentProcessId string       `tfsdk:"parent_process_id"`
+	ReferenceName   types.String `tfsdk:"reference_name"`
+}
+
+func (r *ProcessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process"
+}
+
+func (r *ProcessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage an inherited process within Azure DevOps, which customizes the work item types of a system process.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the process.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the process, to be used as `process_template_id` of the projects.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to make the process the default process of the organization, used by the new projects. A process stops being the default one when another process is made the default one. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to false to prevent new projects from using the process. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the process.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"parent_process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the system process the process inherits from, e.g. the ID of the `Agile` process. Changing this forces a new process to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the process, generated from its name when not set. Changing this forces a new process to be created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentProcessId := uuid.MustParse(model.ParentProcessId)
+	process, err := r.client.CreateProcess(ctx, &processes.CreateProcessModel{
+		Description:         utils.IfThenElse[*string](model.Description != nil, model.Description, utils.EmptyString),
+		Name:                &model.Name,
+		ParentProcessTypeId: &parentProcessId,
+		ReferenceName:       getStringPointer(model.ReferenceName),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create process", err.Error())
+		return
+	}
+
+	model.Id = types.StringValue(process.TypeId.String())
+	model.ReferenceName = types.StringPointerValue(process.ReferenceName)
+
+	// A new process is enabled and is not the default one
+	if model.IsDefault.ValueBool() || !model.IsEnabled.ValueBool() {
+		_, err = r.client.UpdateProcess(ctx, model.Id.ValueString(), &processes.UpdateProcessModel{
+			IsDefault: utils.IfThenElse[*bool](model.IsDefault.ValueBool(), utils.Bool(true), nil),
+			IsEnabled: model.IsEnabled.ValueBoolPointer(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update process", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	process, err := r.client.GetProcess(ctx, model.Id.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the process", err.Error())
+		return
+	}
+
+	if process.CustomizationType != nil && *process.CustomizationType == processes.CustomizationTypeSystem {
+		resp.Diagnostics.AddError("Unable to manage the process", fmt.Sprintf("'%s' is a system process, only the inherited processes can be managed", *process.Name))
+		return
+	}
+
+	if process.Description != nil && *process.Description != "" {
+		model.Description = process.Description
+	} else if model.Description != nil {
+		model.Description = utils.EmptyString
+	}
+	model.IsDefault = types.BoolValue(process.IsDefault != nil && *process.IsDefault)
+	model.IsEnabled = types.BoolValue(process.IsEnabled != nil && *process.IsEnabled)
+	model.Name = *process.Name
+	model.ParentProcessId = process.ParentProcessTypeId.String()
+	model.ReferenceName = types.StringPointerValue(process.ReferenceName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default process can't be unset, another process must be made the default one instead
+	_, err := r.client.UpdateProcess(ctx, model.Id.ValueString(), &processes.UpdateProcessModel{
+		Description: utils.IfThenElse[*string](model.Description != nil, model.Description, utils.EmptyString),
+		IsDefault:   utils.IfThenElse[*bool](model.IsDefault.ValueBool(), utils.Bool(true), nil),
+		IsEnabled:   model.IsEnabled.ValueBoolPointer(),
+		Name:        &model.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update process", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteProcess(ctx, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete process", err.Error())
+	}
+}
+
+func (r *ProcessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	processId, err := getProcessId(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessResourceModel{Id: types.StringValue(processId)})...)
+}
@@ -0,0 +1,245 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessControlResource{}
+var _ resource.ResourceWithImportState = &ProcessControlResource{}
+
+func NewProcessControlResource() resource.Resource {
+	return &ProcessControlResource{}
+}
+
+type ProcessControlResource struct {
+	client *processes.Client
+}
+
+type ProcessControlResourceModel struct {
+	ControlId    string       `tfsdk:"control_id"`
+	GroupId      string       `tfsdk:"group_id"`
+	Label        types.String `tfsdk:"label"`
+	Order        types.Int64  `tfsdk:"order"`
+	ProcessId    string       `tfsdk:"process_id"`
+	Visible      types.Bool   `tfsdk:"visible"`
+	WorkItemType string       `tfsdk:"work_item_type"`
+}
+
+func (r *ProcessControlResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_control"
+}
+
+func (r *ProcessControlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a control of a group of the layout of a work item type of an inherited process within Azure DevOps, which shows a field of the work item type.",
+		Attributes: map[string]schema.Attribute{
+			"control_id": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the field shown by the control, e.g. `Custom.Severity`. Changing this forces a new control to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the group of the control. Changing this forces a new control to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the control, the name of the field when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"order": schema.Int64Attribute{
+				MarkdownDescription: "The position of the control within its group.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the inherited process. Changing this forces a new control to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"visible": schema.BoolAttribute{
+				MarkdownDescription: "Set to false to hide the control. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"work_item_type": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type. Changing this forces a new control to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessControlResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	control, err := r.client.AddControl(ctx, model.ProcessId, model.WorkItemType, model.GroupId, &processes.Control{
+		Id:      &model.ControlId,
+		Label:   getStringPointer(model.Label),
+		Order:   getIntPointer(model.Order),
+		Visible: model.Visible.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create control", err.Error())
+		return
+	}
+
+	readProcessControl(control, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layout, err := r.client.GetLayout(ctx, model.ProcessId, model.WorkItemType)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the layout of the work item type", err.Error())
+		return
+	}
+
+	control := findControl(layout, model.GroupId, model.ControlId)
+	if control == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readProcessControl(control, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	control, err := r.client.UpdateControl(ctx, model.ProcessId, model.WorkItemType, model.GroupId, model.ControlId, &processes.Control{
+		Label:   getStringPointer(model.Label),
+		Order:   getIntPointer(model.Order),
+		Visible: model.Visible.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update control", err.Error())
+		return
+	}
+
+	readProcessControl(control, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveControl(ctx, model.ProcessId, model.WorkItemType, model.GroupId, model.ControlId)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete control", err.Error())
+	}
+}
+
+func (r *ProcessControlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "processId/workItemType/groupId/controlId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	processId, err := getProcessId(ctx, r.client, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", parts[0]), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessControlResourceModel{
+		ControlId:    parts[3],
+		GroupId:      parts[2],
+		ProcessId:    processId,
+		WorkItemType: parts[1],
+	})...)
+}
+
+// Private Methods
+
+func readProcessControl(control *processes.Control, model *ProcessControlResourceModel) {
+	model.ControlId = *control.Id
+	model.Label = types.StringPointerValue(control.Label)
+	model.Order = types.Int64Null()
+	if control.Order != nil {
+		model.Order = types.Int64Value(int64(*control.Order))
+	}
+	model.Visible = types.BoolValue(control.Visible == nil || *control.Visible)
+}
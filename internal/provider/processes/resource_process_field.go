@@ -0,0 +1,276 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessFieldResource{}
+var _ resource.ResourceWithImportState = &ProcessFieldResource{}
+var _ resource.ResourceWithModifyPlan = &ProcessFieldResource{}
+
+func NewProcessFieldResource() resource.Resource {
+	return &ProcessFieldResource{}
+}
+
+type ProcessFieldResource struct {
+	client *workitems.Client
+}
+
+type ProcessFieldResourceModel struct {
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	Description        *string      `tfsdk:"description"`
+	Name               string       `tfsdk:"name"`
+	PicklistId         *string      `tfsdk:"picklist_id"`
+	ReferenceName      types.String `tfsdk:"reference_name"`
+	Type               string       `tfsdk:"type"`
+}
+
+func (r *ProcessFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_field"
+}
+
+func (r *ProcessFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a custom field of the work items of the organization within Azure DevOps, which can then be added to the work item types of the inherited processes. Azure DevOps doesn't allow a field to be changed, the changes of its name, type, picklist or reference name force a new field to be created, which deletes the values of the field in all the work items of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to refuse to delete the field, including when a change would replace it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the field. It cannot be changed once the field is created.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the field.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"picklist_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the picklist of the allowed values of the field, for a field of type `string`, `integer` or `double`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the field, e.g. `Custom.Severity`, generated from its name when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the field. Must be `boolean`, `dateTime`, `double`, `html`, `identity`, `integer`, `plainText` or `string`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						workitems.FieldTypeBoolean,
+						workitems.FieldTypeDateTime,
+						workitems.FieldTypeDouble,
+						workitems.FieldTypeHtml,
+						workitems.FieldTypeIdentity,
+						workitems.FieldTypeInteger,
+						workitems.FieldTypePlainText,
+						workitems.FieldTypeString,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).WorkItemsClient
+}
+
+func (r *ProcessFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field := &workitems.WorkItemField{
+		Description:   model.Description,
+		Name:          &model.Name,
+		ReferenceName: getStringPointer(model.ReferenceName),
+		Type:          &model.Type,
+		Usage:         utils.String(workitems.FieldUsageWorkItem),
+	}
+	if model.PicklistId != nil {
+		picklistId := uuid.MustParse(*model.PicklistId)
+		field.IsPicklist = utils.Bool(true)
+		field.PicklistId = &picklistId
+	}
+
+	result, err := r.client.CreateField(ctx, field)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create field", err.Error())
+		return
+	}
+
+	model.ReferenceName = types.StringPointerValue(result.ReferenceName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := r.client.GetField(ctx, model.ReferenceName.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the field", err.Error())
+		return
+	}
+
+	if field.IsDeleted != nil && *field.IsDeleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if field.Description != nil && *field.Description != "" {
+		model.Description = field.Description
+	} else if model.Description != nil {
+		model.Description = utils.EmptyString
+	}
+	model.Name = *field.Name
+	model.PicklistId = nil
+	if field.PicklistId != nil {
+		model.PicklistId = utils.String(field.PicklistId.String())
+	}
+	model.ReferenceName = types.StringPointerValue(field.ReferenceName)
+	model.Type = *field.Type
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var currentModel *ProcessFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentModel)...)
+
+	var newModel *ProcessFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &newModel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The other changes replace the field, only the attributes of the resource are updated
+	if !types.StringPointerValue(currentModel.Description).Equal(types.StringPointerValue(newModel.Description)) {
+		addDescriptionChangedError(&resp.Diagnostics, newModel.Name)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
+}
+
+func (r *ProcessFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(fmt.Sprintf("Field '%s' is protected against deletion", model.Name), "Set 'deletion_protection' to false and apply the configuration before deleting the field, which deletes its values in all the work items of the organization.")
+		return
+	}
+
+	err := r.client.DeleteField(ctx, model.ReferenceName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete field", err.Error())
+	}
+}
+
+func (r *ProcessFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	field, err := r.client.GetField(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find field '%s'", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessFieldResourceModel{DeletionProtection: types.BoolValue(false), ReferenceName: types.StringPointerValue(field.ReferenceName)})...)
+}
+
+func (r *ProcessFieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not check the plan when creating or deleting a resource
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// A change of description is allowed when the field is replaced anyway
+	for _, attribute := range []string{"name", "picklist_id", "reference_name", "type"} {
+		var currentValue, newValue types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attribute), &currentValue)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &newValue)...)
+		if resp.Diagnostics.HasError() || !currentValue.Equal(newValue) {
+			return
+		}
+	}
+
+	var currentDescription, newDescription, name types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("description"), &currentDescription)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("description"), &newDescription)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || newDescription.IsUnknown() || currentDescription.Equal(newDescription) {
+		return
+	}
+
+	addDescriptionChangedError(&resp.Diagnostics, name.ValueString())
+}
+
+// Private Methods
+
+// addDescriptionChangedError reports a change of the description of a field, which Azure DevOps doesn't allow. The
+// field isn't replaced for that, since it would delete its values in all the work items of the organization.
+func addDescriptionChangedError(diags *diag.Diagnostics, name string) {
+	diags.AddAttributeError(path.Root("description"), fmt.Sprintf("The description of field '%s' cannot be changed", name), "Azure DevOps doesn't allow the description of a field to be changed. Restore the description of the field, or remove the field from the configuration and add it back to create a new field, which deletes its values in all the work items of the organization.")
+}
@@ -0,0 +1,79 @@
+package processes_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"regexp"
+	"testing"
+)
+
+func TestAccProcessFieldResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProcessFieldDestroyed(server, "Custom.RiskSeverity"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProcessFieldConfig(server.URL, "Risk Severity", "The severity of the risk.", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_process_field.test", "reference_name", "Custom.RiskSeverity"),
+					resource.TestCheckResourceAttr("azuredevops_process_field.test", "description", "The severity of the risk."),
+				),
+			},
+			{
+				// The field is not replaced for a change of description, which would delete its values
+				Config:      testAccProcessFieldConfig(server.URL, "Risk Severity", "The impact of the risk.", true),
+				ExpectError: regexp.MustCompile("The description of field 'Risk Severity' cannot be changed"),
+			},
+			{
+				Config:      testAccProcessFieldConfig(server.URL, "Risk Impact", "The severity of the risk.", true),
+				ExpectError: regexp.MustCompile("Field 'Risk Severity' is protected against deletion"),
+			},
+			{
+				Config: testAccProcessFieldConfig(server.URL, "Risk Severity", "The severity of the risk.", false),
+				Check:  resource.TestCheckResourceAttr("azuredevops_process_field.test", "deletion_protection", "false"),
+			},
+			{
+				ResourceName:                         "azuredevops_process_field.test",
+				ImportState:                          true,
+				ImportStateId:                        "Custom.RiskSeverity",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "reference_name",
+			},
+		},
+	})
+}
+
+func testAccCheckProcessFieldDestroyed(server *fakeserver.Server, referenceName string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		field, err := acctest.NewClient(server).WorkItemsClient.GetField(context.Background(), referenceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if field.IsDeleted == nil || !*field.IsDeleted {
+			return fmt.Errorf("field '%s' still exists", referenceName)
+		}
+		return nil
+	}
+}
+
+func testAccProcessFieldConfig(organizationUrl string, name string, description string, deletionProtection bool) string {
+	return acctest.ProviderConfig(organizationUrl) + fmt.Sprintf(`
+resource "azuredevops_process_field" "test" {
+  deletion_protection = %t
+  description         = %q
+  name                = %q
+  reference_name      = "Custom.RiskSeverity"
+  type                = "string"
+}
+`, deletionProtection, description, name)
+}
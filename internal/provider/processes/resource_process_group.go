@@ -0,0 +1,252 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessGroupResource{}
+var _ resource.ResourceWithImportState = &ProcessGroupResource{}
+
+func NewProcessGroupResource() resource.Resource {
+	return &ProcessGroupResource{}
+}
+
+type ProcessGroupResource struct {
+	client *processes.Client
+}
+
+type ProcessGroupResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Label        string       `tfsdk:"label"`
+	Order        types.Int64  `tfsdk:"order"`
+	PageId       string       `tfsdk:"page_id"`
+	ProcessId    string       `tfsdk:"process_id"`
+	SectionId    string       `tfsdk:"section_id"`
+	Visible      types.Bool   `tfsdk:"visible"`
+	WorkItemType string       `tfsdk:"work_item_type"`
+}
+
+func (r *ProcessGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_group"
+}
+
+func (r *ProcessGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a custom group of a page of the layout of a work item type of an inherited process within Azure DevOps.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the group.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"order": schema.Int64Attribute{
+				MarkdownDescription: "The position of the group within its section.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"page_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the page of the group. Changing this forces a new group to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the inherited process. Changing this forces a new group to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"section_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the section of the page holding the group, e.g. `Section1`. Changing this forces a new group to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"visible": schema.BoolAttribute{
+				MarkdownDescription: "Set to false to hide the group. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"work_item_type": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type. Changing this forces a new group to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.AddGroup(ctx, model.ProcessId, model.WorkItemType, model.PageId, model.SectionId, &processes.Group{
+		Label:   &model.Label,
+		Order:   getIntPointer(model.Order),
+		Visible: model.Visible.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create group", err.Error())
+		return
+	}
+
+	readProcessGroup(group, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layout, err := r.client.GetLayout(ctx, model.ProcessId, model.WorkItemType)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the layout of the work item type", err.Error())
+		return
+	}
+
+	page, section, group := findGroup(layout, model.Id.ValueString())
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model.PageId = *page.Id
+	model.SectionId = *section.Id
+	readProcessGroup(group, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.UpdateGroup(ctx, model.ProcessId, model.WorkItemType, model.PageId, model.SectionId, model.Id.ValueString(), &processes.Group{
+		Label:   &model.Label,
+		Order:   getIntPointer(model.Order),
+		Visible: model.Visible.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update group", err.Error())
+		return
+	}
+
+	readProcessGroup(group, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveGroup(ctx, model.ProcessId, model.WorkItemType, model.PageId, model.SectionId, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete group", err.Error())
+	}
+}
+
+func (r *ProcessGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "processId/workItemType/groupId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	processId, err := getProcessId(ctx, r.client, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", parts[0]), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessGroupResourceModel{
+		Id:           types.StringValue(parts[2]),
+		ProcessId:    processId,
+		WorkItemType: parts[1],
+	})...)
+}
+
+// Private Methods
+
+func readProcessGroup(group *processes.Group, model *ProcessGroupResourceModel) {
+	model.Id = types.StringPointerValue(group.Id)
+	model.Label = *group.Label
+	model.Order = types.Int64Null()
+	if group.Order != nil {
+		model.Order = types.Int64Value(int64(*group.Order))
+	}
+	model.Visible = types.BoolValue(group.Visible == nil || *group.Visible)
+}
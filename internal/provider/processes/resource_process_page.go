@@ -0,0 +1,230 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessPageResource{}
+var _ resource.ResourceWithImportState = &ProcessPageResource{}
+
+func NewProcessPageResource() resource.Resource {
+	return &ProcessPageResource{}
+}
+
+type ProcessPageResource struct {
+	client *processes.Client
+}
+
+type ProcessPageResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Label        string       `tfsdk:"label"`
+	Order        types.Int64  `tfsdk:"order"`
+	ProcessId    string       `tfsdk:"process_id"`
+	Visible      types.Bool   `tfsdk:"visible"`
+	WorkItemType string       `tfsdk:"work_item_type"`
+}
+
+func (r *ProcessPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_page"
+}
+
+func (r *ProcessPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a custom page of the layout of a work item type of an inherited process within Azure DevOps. A custom page has three sections, `Section1`, `Section2` and `Section3`, which hold its groups.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the page.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the page.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"order": schema.Int64Attribute{
+				MarkdownDescription: "The position of the page within the layout.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the inherited process. Changing this forces a new page to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"visible": schema.BoolAttribute{
+				MarkdownDescription: "Set to false to hide the page. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"work_item_type": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type. Changing this forces a new page to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessPageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessPageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.AddPage(ctx, model.ProcessId, model.WorkItemType, &processes.Page{
+		Label:    &model.Label,
+		Order:    getIntPointer(model.Order),
+		PageType: utils.String(processes.PageTypeCustom),
+		Visible:  model.Visible.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create page", err.Error())
+		return
+	}
+
+	readProcessPage(page, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessPageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layout, err := r.client.GetLayout(ctx, model.ProcessId, model.WorkItemType)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the layout of the work item type", err.Error())
+		return
+	}
+
+	page := findPage(layout, model.Id.ValueString())
+	if page == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readProcessPage(page, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessPageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.UpdatePage(ctx, model.ProcessId, model.WorkItemType, &processes.Page{
+		Id:      utils.String(model.Id.ValueString()),
+		Label:   &model.Label,
+		Order:   getIntPointer(model.Order),
+		Visible: model.Visible.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update page", err.Error())
+		return
+	}
+
+	readProcessPage(page, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessPageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemovePage(ctx, model.ProcessId, model.WorkItemType, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete page", err.Error())
+	}
+}
+
+func (r *ProcessPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "processId/workItemType/pageId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	processId, err := getProcessId(ctx, r.client, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", parts[0]), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessPageResourceModel{
+		Id:           types.StringValue(parts[2]),
+		ProcessId:    processId,
+		WorkItemType: parts[1],
+	})...)
+}
+
+// Private Methods
+
+func readProcessPage(page *processes.Page, model *ProcessPageResourceModel) {
+	model.Id = types.StringPointerValue(page.Id)
+	model.Label = *page.Label
+	model.Order = types.Int64Null()
+	if page.Order != nil {
+		model.Order = types.Int64Value(int64(*page.Order))
+	}
+	model.Visible = types.BoolValue(page.Visible == nil || *page.Visible)
+}
@@ -0,0 +1,200 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessPicklistResource{}
+var _ resource.ResourceWithImportState = &ProcessPicklistResource{}
+
+func NewProcessPicklistResource() resource.Resource {
+	return &ProcessPicklistResource{}
+}
+
+type ProcessPicklistResource struct {
+	client *processes.Client
+}
+
+type ProcessPicklistResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	IsSuggested types.Bool   `tfsdk:"is_suggested"`
+	Items       []string     `tfsdk:"items"`
+	Name        string       `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (r *ProcessPicklistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_picklist"
+}
+
+func (r *ProcessPicklistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a picklist of the organization within Azure DevOps, which lists the allowed values of a custom field.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the picklist.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_suggested": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to let users enter values missing from the picklist. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"items": schema.ListAttribute{
+				MarkdownDescription: "The values of the picklist, in the order they are shown.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the picklist.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the values of the picklist. Must be `String`, `Integer` or `Double`. Defaults to `String`. Changing this forces a new picklist to be created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(processes.PicklistTypeString),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(processes.PicklistTypeString, processes.PicklistTypeInteger, processes.PicklistTypeDouble),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessPicklistResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessPicklistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessPicklistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	picklist, err := r.client.CreatePicklist(ctx, &processes.PickList{
+		IsSuggested: model.IsSuggested.ValueBoolPointer(),
+		Items:       &model.Items,
+		Name:        &model.Name,
+		Type:        model.Type.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create picklist", err.Error())
+		return
+	}
+
+	model.Id = types.StringValue(picklist.Id.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessPicklistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessPicklistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	picklist, err := r.client.GetPicklist(ctx, model.Id.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the picklist", err.Error())
+		return
+	}
+
+	model.IsSuggested = types.BoolValue(picklist.IsSuggested != nil && *picklist.IsSuggested)
+	model.Items = []string{}
+	if picklist.Items != nil {
+		model.Items = *picklist.Items
+	}
+	model.Name = *picklist.Name
+	model.Type = types.StringPointerValue(picklist.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessPicklistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessPicklistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdatePicklist(ctx, model.Id.ValueString(), &processes.PickList{
+		Id:          utils.UUID(model.Id.ValueString()),
+		IsSuggested: model.IsSuggested.ValueBoolPointer(),
+		Items:       &model.Items,
+		Name:        &model.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update picklist", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessPicklistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessPicklistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePicklist(ctx, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete picklist", err.Error())
+	}
+}
+
+func (r *ProcessPicklistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := uuid.Parse(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", fmt.Sprintf("'%s' is not a valid picklist ID", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessPicklistResourceModel{Id: types.StringValue(req.ID)})...)
+}
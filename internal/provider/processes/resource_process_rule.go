@@ -0,0 +1,321 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessRuleResource{}
+var _ resource.ResourceWithImportState = &ProcessRuleResource{}
+
+func NewProcessRuleResource() resource.Resource {
+	return &ProcessRuleResource{}
+}
+
+type ProcessRuleResource struct {
+	client *processes.Client
+}
+
+type ProcessRuleResourceModel struct {
+	Actions      []ProcessRuleActionModel    `tfsdk:"actions"`
+	Conditions   []ProcessRuleConditionModel `tfsdk:"conditions"`
+	Id           types.String                `tfsdk:"id"`
+	IsDisabled   types.Bool                  `tfsdk:"is_disabled"`
+	Name         string                      `tfsdk:"name"`
+	ProcessId    string                      `tfsdk:"process_id"`
+	WorkItemType string                      `tfsdk:"work_item_type"`
+}
+
+type ProcessRuleActionModel struct {
+	ActionType  string  `tfsdk:"action_type"`
+	TargetField string  `tfsdk:"target_field"`
+	Value       *string `tfsdk:"value"`
+}
+
+type ProcessRuleConditionModel struct {
+	ConditionType string  `tfsdk:"condition_type"`
+	Field         *string `tfsdk:"field"`
+	Value         *string `tfsdk:"value"`
+}
+
+func (r *ProcessRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_rule"
+}
+
+func (r *ProcessRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a rule of a work item type of an inherited process within Azure DevOps, which runs actions on the fields of the work items matching its conditions.",
+		Attributes: map[string]schema.Attribute{
+			"actions": schema.ListNestedAttribute{
+				MarkdownDescription: "The actions run when the conditions are met.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the action, e.g. `makeRequired`, `makeReadOnly`, `setDefaultValue`, `setValueToEmpty`, `copyValue`, `copyFromCurrentUser`, `copyFromClock` or `hideTargetField`.",
+							Required:            true,
+							Validators: []validator.String{
+								validators.StringNotEmpty(),
+							},
+						},
+						"target_field": schema.StringAttribute{
+							MarkdownDescription: "The reference name of the field the action applies to.",
+							Required:            true,
+							Validators: []validator.String{
+								validators.StringNotEmpty(),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value used by the action, e.g. the value set by `setDefaultValue`.",
+							Optional:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"conditions": schema.ListNestedAttribute{
+				MarkdownDescription: "The conditions of the rule, at most two.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"condition_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the condition, e.g. `when`, `whenNot`, `whenChanged`, `whenNotChanged`, `whenWas`, `whenStateChangedTo`, `whenWorkItemIsCreated`, `whenValueIsDefined` or `whenCurrentUserIsMemberOfGroup`.",
+							Required:            true,
+							Validators: []validator.String{
+								validators.StringNotEmpty(),
+							},
+						},
+						"field": schema.StringAttribute{
+							MarkdownDescription: "The reference name of the field tested by the condition.",
+							Optional:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value tested by the condition.",
+							Optional:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to disable the rule. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the rule.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+					validators.StringNotEmpty(),
+				},
+			},
+			"process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the inherited process. Changing this forces a new rule to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"work_item_type": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type. Changing this forces a new rule to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.CreateRule(ctx, model.ProcessId, model.WorkItemType, getProcessRuleRequest(model))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create rule", err.Error())
+		return
+	}
+
+	model.Id = types.StringValue(rule.Id.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetRule(ctx, model.ProcessId, model.WorkItemType, model.Id.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the rule", err.Error())
+		return
+	}
+
+	model.Actions = []ProcessRuleActionModel{}
+	if rule.Actions != nil {
+		for _, action := range *rule.Actions {
+			actionModel := ProcessRuleActionModel{Value: action.Value}
+			if action.ActionType != nil {
+				actionModel.ActionType = *action.ActionType
+			}
+			if action.TargetField != nil {
+				actionModel.TargetField = *action.TargetField
+			}
+			model.Actions = append(model.Actions, actionModel)
+		}
+	}
+	model.Conditions = []ProcessRuleConditionModel{}
+	if rule.Conditions != nil {
+		for _, condition := range *rule.Conditions {
+			conditionModel := ProcessRuleConditionModel{Field: condition.Field, Value: condition.Value}
+			if condition.ConditionType != nil {
+				conditionModel.ConditionType = *condition.ConditionType
+			}
+			model.Conditions = append(model.Conditions, conditionModel)
+		}
+	}
+	model.IsDisabled = types.BoolValue(rule.IsDisabled != nil && *rule.IsDisabled)
+	if rule.Name != nil {
+		model.Name = *rule.Name
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateRule(ctx, model.ProcessId, model.WorkItemType, model.Id.ValueString(), getProcessRuleRequest(model))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRule(ctx, model.ProcessId, model.WorkItemType, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete rule", err.Error())
+	}
+}
+
+func (r *ProcessRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "processId/workItemType/ruleId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	processId, err := getProcessId(ctx, r.client, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", parts[0]), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessRuleResourceModel{
+		Id:           types.StringValue(parts[2]),
+		ProcessId:    processId,
+		WorkItemType: parts[1],
+	})...)
+}
+
+// Private Methods
+
+func getProcessRuleRequest(model *ProcessRuleResourceModel) *processes.ProcessRuleRequest {
+	var actions []processes.RuleAction
+	for _, action := range model.Actions {
+		actions = append(actions, processes.RuleAction{
+			ActionType:  utils.String(action.ActionType),
+			TargetField: utils.String(action.TargetField),
+			Value:       action.Value,
+		})
+	}
+
+	var conditions []processes.RuleCondition
+	for _, condition := range model.Conditions {
+		conditions = append(conditions, processes.RuleCondition{
+			ConditionType: utils.String(condition.ConditionType),
+			Field:         condition.Field,
+			Value:         condition.Value,
+		})
+	}
+
+	request := &processes.ProcessRuleRequest{
+		Actions:    &actions,
+		Conditions: &conditions,
+		IsDisabled: model.IsDisabled.ValueBoolPointer(),
+		Name:       &model.Name,
+	}
+	if !model.Id.IsNull() && !model.Id.IsUnknown() {
+		request.Id = utils.UUID(model.Id.ValueString())
+	}
+	return request
+}
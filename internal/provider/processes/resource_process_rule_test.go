@@ -0,0 +1,127 @@
+package processes_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"testing"
+)
+
+func TestAccProcessRuleResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProcessRuleConfig(server.URL, false, "007acc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("azuredevops_process_rule.test", "id"),
+					resource.TestCheckResourceAttr("azuredevops_process_rule.test", "actions.0.action_type", "makeRequired"),
+					resource.TestCheckResourceAttr("azuredevops_process_rule.test", "actions.0.target_field", "Custom.RiskSeverity"),
+					resource.TestCheckResourceAttr("azuredevops_process_rule.test", "conditions.0.condition_type", "when"),
+					resource.TestCheckResourceAttr("azuredevops_process_state.test", "state_category", "InProgress"),
+					testAccCheckProcessRuleDisabled(server, false),
+				),
+			},
+			{
+				Config: testAccProcessRuleConfig(server.URL, true, "b2b2b2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_process_state.test", "color", "b2b2b2"),
+					testAccCheckProcessRuleDisabled(server, true),
+				),
+			},
+			{
+				ResourceName:      "azuredevops_process_rule.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProcessRuleImportStateId,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "azuredevops_process_state.test",
+				ImportState:       true,
+				ImportStateId:     "Custom Agile/CustomAgile.Risk/Triaged",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProcessRuleDisabled(server *fakeserver.Server, isDisabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rule := s.RootModule().Resources["azuredevops_process_rule.test"].Primary
+		result, err := acctest.NewClient(server).ProcessesClient.GetRule(context.Background(), rule.Attributes["process_id"], rule.Attributes["work_item_type"], rule.ID)
+		if err != nil {
+			return err
+		}
+		if actual := result.IsDisabled != nil && *result.IsDisabled; actual != isDisabled {
+			return fmt.Errorf("expected the rule to be disabled %t, got %t", isDisabled, actual)
+		}
+		return nil
+	}
+}
+
+func testAccProcessRuleImportStateId(s *terraform.State) (string, error) {
+	rule := s.RootModule().Resources["azuredevops_process_rule.test"].Primary
+	return fmt.Sprintf("Custom Agile/%s/%s", rule.Attributes["work_item_type"], rule.ID), nil
+}
+
+func testAccProcessRuleConfig(organizationUrl string, isDisabled bool, color string) string {
+	return testAccProcessFieldConfig(organizationUrl, "Risk Severity", "The severity of the risk.", false) + fmt.Sprintf(`
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_process" "test" {
+  name              = "Custom Agile"
+  parent_process_id = data.azuredevops_process.agile.id
+  reference_name    = "CustomAgile"
+}
+
+resource "azuredevops_process_work_item_type" "test" {
+  color      = "ff9d00"
+  icon       = "icon_insect"
+  name       = "Risk"
+  process_id = azuredevops_process.test.id
+}
+
+resource "azuredevops_process_work_item_type_field" "test" {
+  field          = azuredevops_process_field.test.reference_name
+  process_id     = azuredevops_process.test.id
+  work_item_type = azuredevops_process_work_item_type.test.reference_name
+}
+
+resource "azuredevops_process_state" "test" {
+  color          = %q
+  name           = "Triaged"
+  process_id     = azuredevops_process.test.id
+  state_category = "InProgress"
+  work_item_type = azuredevops_process_work_item_type.test.reference_name
+}
+
+resource "azuredevops_process_rule" "test" {
+  is_disabled    = %t
+  name           = "Require the severity of the active risks"
+  process_id     = azuredevops_process.test.id
+  work_item_type = azuredevops_process_work_item_type.test.reference_name
+
+  actions = [
+    {
+      action_type  = "makeRequired"
+      target_field = azuredevops_process_work_item_type_field.test.field
+    }
+  ]
+
+  conditions = [
+    {
+      condition_type = "when"
+      field          = "System.State"
+      value          = azuredevops_process_state.test.name
+    }
+  ]
+}
+`, color, isDisabled)
+}
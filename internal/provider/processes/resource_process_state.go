@@ -0,0 +1,275 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"regexp"
+	"strings"
+)
+
+var _ resource.Resource = &ProcessStateResource{}
+var _ resource.ResourceWithImportState = &ProcessStateResource{}
+
+func NewProcessStateResource() resource.Resource {
+	return &ProcessStateResource{}
+}
+
+type ProcessStateResource struct {
+	client *processes.Client
+}
+
+type ProcessStateResourceModel struct {
+	Color         string       `tfsdk:"color"`
+	Id            types.String `tfsdk:"id"`
+	Name          string       `tfsdk:"name"`
+	Order         types.Int64  `tfsdk:"order"`
+	ProcessId     string       `tfsdk:"process_id"`
+	StateCategory string       `tfsdk:"state_category"`
+	WorkItemType  string       `tfsdk:"work_item_type"`
+}
+
+func (r *ProcessStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_state"
+}
+
+func (r *ProcessStateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a custom state of a work item type of an inherited process within Azure DevOps.",
+		Attributes: map[string]schema.Attribute{
+			"color": schema.StringAttribute{
+				MarkdownDescription: "The color of the state, as an hexadecimal RGB value without `#`, e.g. `b2b2b2`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[0-9a-fA-F]{6}$"), "must be an hexadecimal RGB value (eg. b2b2b2)"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the state.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the state. Changing this forces a new state to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"order": schema.Int64Attribute{
+				MarkdownDescription: "The position of the state within its category.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the inherited process. Changing this forces a new state to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"state_category": schema.StringAttribute{
+				MarkdownDescription: "The category of the state, which drives how the boards and the backlogs show it. Must be `Proposed`, `InProgress`, `Resolved`, `Completed` or `Removed`. Changing this forces a new state to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						processes.StateCategoryProposed,
+						processes.StateCategoryInProgress,
+						processes.StateCategoryResolved,
+						processes.StateCategoryCompleted,
+						processes.StateCategoryRemoved,
+					),
+				},
+			},
+			"work_item_type": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type. Changing this forces a new state to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessStateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.client.CreateState(ctx, model.ProcessId, model.WorkItemType, &processes.WorkItemStateInputModel{
+		Color:         &model.Color,
+		Name:          &model.Name,
+		Order:         getIntPointer(model.Order),
+		StateCategory: &model.StateCategory,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create state", err.Error())
+		return
+	}
+
+	readProcessState(state, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.client.GetState(ctx, model.ProcessId, model.WorkItemType, model.Id.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the state", err.Error())
+		return
+	}
+
+	readProcessState(state, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.client.UpdateState(ctx, model.ProcessId, model.WorkItemType, model.Id.ValueString(), &processes.WorkItemStateInputModel{
+		Color: &model.Color,
+		Order: getIntPointer(model.Order),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update state", err.Error())
+		return
+	}
+
+	readProcessState(state, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteState(ctx, model.ProcessId, model.WorkItemType, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete state", err.Error())
+	}
+}
+
+func (r *ProcessStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "processId/workItemType/state")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	processId, err := getProcessId(ctx, r.client, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", parts[0]), err.Error())
+		return
+	}
+
+	// The state is given by either its ID or its name
+	stateId := parts[2]
+	if _, err = uuid.Parse(stateId); err != nil {
+		states, err := r.client.GetStates(ctx, processId, parts[1])
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve the states of work item type '%s'", parts[1]), err.Error())
+			return
+		}
+
+		stateId = ""
+		for _, state := range *states {
+			if state.Name != nil && strings.EqualFold(*state.Name, parts[2]) {
+				stateId = state.Id.String()
+				break
+			}
+		}
+		if stateId == "" {
+			resp.Diagnostics.AddError("Unable to import state", fmt.Sprintf("Unable to find state '%s'", parts[2]))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessStateResourceModel{
+		Id:           types.StringValue(stateId),
+		ProcessId:    processId,
+		WorkItemType: parts[1],
+	})...)
+}
+
+// Private Methods
+
+func readProcessState(state *processes.WorkItemStateResultModel, model *ProcessStateResourceModel) {
+	if state.Color != nil {
+		model.Color = *state.Color
+	}
+	model.Id = types.StringValue(state.Id.String())
+	if state.Name != nil {
+		model.Name = *state.Name
+	}
+	model.Order = types.Int64Null()
+	if state.Order != nil {
+		model.Order = types.Int64Value(int64(*state.Order))
+	}
+	if state.StateCategory != nil {
+		model.StateCategory = *state.StateCategory
+	}
+}
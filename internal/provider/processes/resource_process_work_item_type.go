@@ -0,0 +1,281 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"regexp"
+)
+
+var _ resource.Resource = &ProcessWorkItemTypeResource{}
+var _ resource.ResourceWithImportState = &ProcessWorkItemTypeResource{}
+
+func NewProcessWorkItemTypeResource() resource.Resource {
+	return &ProcessWorkItemTypeResource{}
+}
+
+type ProcessWorkItemTypeResource struct {
+	client *processes.Client
+}
+
+type ProcessWorkItemTypeResourceModel struct {
+	Color              types.String `tfsdk:"color"`
+	Description        types.String `tfsdk:"description"`
+	Icon               types.String `tfsdk:"icon"`
+	IsDisabled         types.Bool   `tfsdk:"is_disabled"`
+	Name               types.String `tfsdk:"name"`
+	ParentWorkItemType *string      `tfsdk:"parent_work_item_type"`
+	ProcessId          string       `tfsdk:"process_id"`
+	ReferenceName      types.String `tfsdk:"reference_name"`
+}
+
+func (r *ProcessWorkItemTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_work_item_type"
+}
+
+func (r *ProcessWorkItemTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a work item type of an inherited process within Azure DevOps, either a custom work item type or a work item type of the parent process overridden to be customized. Destroying an overridden work item type removes all its customizations.",
+		Attributes: map[string]schema.Attribute{
+			"color": schema.StringAttribute{
+				MarkdownDescription: "The color of the work item type, as an hexadecimal RGB value without `#`, e.g. `009CCC`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[0-9a-fA-F]{6}$"), "must be an hexadecimal RGB value (eg. 009CCC)"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the work item type.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "The icon of the work item type, e.g. `icon_clipboard`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to prevent the creation of new work items of this type. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of a custom work item type. Exactly one of `name` or `parent_work_item_type` must be set, the name of an overridden work item type being the one of its parent. Changing this forces a new work item type to be created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("parent_work_item_type")),
+					validators.StringNotEmpty(),
+				},
+			},
+			"parent_work_item_type": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type of the parent process to override, e.g. `Microsoft.VSTS.WorkItemTypes.Bug`. Changing this forces a new work item type to be created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the inherited process. Changing this forces a new work item type to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type, used by the other resources of the process.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessWorkItemTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessWorkItemTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessWorkItemTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &processes.CreateProcessWorkItemTypeRequest{
+		Color:       getStringPointer(model.Color),
+		Description: getStringPointer(model.Description),
+		Icon:        getStringPointer(model.Icon),
+		IsDisabled:  model.IsDisabled.ValueBoolPointer(),
+		Name:        getStringPointer(model.Name),
+	}
+
+	// An overridden work item type keeps the name of its parent, and its other properties when not set
+	if model.ParentWorkItemType != nil {
+		parent, err := r.client.GetWorkItemType(ctx, model.ProcessId, *model.ParentWorkItemType)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to find work item type '%s'", *model.ParentWorkItemType), err.Error())
+			return
+		}
+
+		request.Color = utils.IfThenElse[*string](request.Color != nil, request.Color, parent.Color)
+		request.Description = utils.IfThenElse[*string](request.Description != nil, request.Description, parent.Description)
+		request.Icon = utils.IfThenElse[*string](request.Icon != nil, request.Icon, parent.Icon)
+		request.InheritsFrom = parent.ReferenceName
+		request.Name = parent.Name
+	}
+
+	workItemType, err := r.client.CreateWorkItemType(ctx, model.ProcessId, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create work item type", err.Error())
+		return
+	}
+
+	readProcessWorkItemType(workItemType, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessWorkItemTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessWorkItemTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workItemType, err := r.client.GetWorkItemType(ctx, model.ProcessId, model.ReferenceName.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the work item type", err.Error())
+		return
+	}
+
+	// The customizations of an overridden work item type have been removed
+	if workItemType.Customization != nil && *workItemType.Customization == processes.CustomizationTypeSystem {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readProcessWorkItemType(workItemType, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessWorkItemTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessWorkItemTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workItemType, err := r.client.UpdateWorkItemType(ctx, model.ProcessId, model.ReferenceName.ValueString(), &processes.UpdateProcessWorkItemTypeRequest{
+		Color:       getStringPointer(model.Color),
+		Description: getStringPointer(model.Description),
+		Icon:        getStringPointer(model.Icon),
+		IsDisabled:  model.IsDisabled.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update work item type", err.Error())
+		return
+	}
+
+	readProcessWorkItemType(workItemType, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessWorkItemTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessWorkItemTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWorkItemType(ctx, model.ProcessId, model.ReferenceName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete work item type", err.Error())
+	}
+}
+
+func (r *ProcessWorkItemTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "processId/referenceName")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	processId, err := getProcessId(ctx, r.client, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", parts[0]), err.Error())
+		return
+	}
+
+	workItemType, err := r.client.GetWorkItemType(ctx, processId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find work item type '%s'", parts[1]), err.Error())
+		return
+	}
+
+	model := &ProcessWorkItemTypeResourceModel{ProcessId: processId}
+	if workItemType.Customization != nil && *workItemType.Customization == processes.CustomizationTypeInherited {
+		model.ParentWorkItemType = workItemType.Inherits
+	}
+	readProcessWorkItemType(workItemType, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Private Methods
+
+func readProcessWorkItemType(workItemType *processes.ProcessWorkItemType, model *ProcessWorkItemTypeResourceModel) {
+	model.Color = types.StringPointerValue(utils.IfThenElse[*string](workItemType.Color != nil, workItemType.Color, utils.EmptyString))
+	model.Description = types.StringPointerValue(utils.IfThenElse[*string](workItemType.Description != nil, workItemType.Description, utils.EmptyString))
+	model.Icon = types.StringPointerValue(utils.IfThenElse[*string](workItemType.Icon != nil, workItemType.Icon, utils.EmptyString))
+	model.IsDisabled = types.BoolValue(workItemType.IsDisabled != nil && *workItemType.IsDisabled)
+	model.Name = types.StringPointerValue(workItemType.Name)
+	model.ReferenceName = types.StringPointerValue(workItemType.ReferenceName)
+}
@@ -0,0 +1,210 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/processes"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+)
+
+var _ resource.Resource = &ProcessWorkItemTypeFieldResource{}
+var _ resource.ResourceWithImportState = &ProcessWorkItemTypeFieldResource{}
+
+func NewProcessWorkItemTypeFieldResource() resource.Resource {
+	return &ProcessWorkItemTypeFieldResource{}
+}
+
+type ProcessWorkItemTypeFieldResource struct {
+	client *processes.Client
+}
+
+type ProcessWorkItemTypeFieldResourceModel struct {
+	DefaultValue *string    `tfsdk:"default_value"`
+	Field        string     `tfsdk:"field"`
+	ProcessId    string     `tfsdk:"process_id"`
+	ReadOnly     types.Bool `tfsdk:"read_only"`
+	Required     types.Bool `tfsdk:"required"`
+	WorkItemType string     `tfsdk:"work_item_type"`
+}
+
+func (r *ProcessWorkItemTypeFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_work_item_type_field"
+}
+
+func (r *ProcessWorkItemTypeFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a field of a work item type of an inherited process within Azure DevOps, either a field added to the work item type or an inherited field whose settings are customized. Destroying an inherited field restores its settings.",
+		Attributes: map[string]schema.Attribute{
+			"default_value": schema.StringAttribute{
+				MarkdownDescription: "The default value of the field for the new work items.",
+				Optional:            true,
+			},
+			"field": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the field, e.g. `Custom.Severity`. Changing this forces a new field to be added.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"process_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the inherited process. Changing this forces a new field to be added.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to prevent users from changing the value of the field. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to require a value for the field. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"work_item_type": schema.StringAttribute{
+				MarkdownDescription: "The reference name of the work item type. Changing this forces a new field to be added.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProcessWorkItemTypeFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ProcessesClient
+}
+
+func (r *ProcessWorkItemTypeFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *ProcessWorkItemTypeFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AddWorkItemTypeField(ctx, model.ProcessId, model.WorkItemType, &processes.ProcessWorkItemTypeFieldRequest{
+		DefaultValue:  model.DefaultValue,
+		ReadOnly:      model.ReadOnly.ValueBoolPointer(),
+		ReferenceName: &model.Field,
+		Required:      model.Required.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add field to work item type", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessWorkItemTypeFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *ProcessWorkItemTypeFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := r.client.GetWorkItemTypeField(ctx, model.ProcessId, model.WorkItemType, model.Field)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the field of the work item type", err.Error())
+		return
+	}
+
+	model.DefaultValue = nil
+	if field.DefaultValue != nil {
+		model.DefaultValue = utils.String(fmt.Sprint(field.DefaultValue))
+	}
+	model.Field = *field.ReferenceName
+	model.ReadOnly = types.BoolValue(field.ReadOnly != nil && *field.ReadOnly)
+	model.Required = types.BoolValue(field.Required != nil && *field.Required)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessWorkItemTypeFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *ProcessWorkItemTypeFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateWorkItemTypeField(ctx, model.ProcessId, model.WorkItemType, model.Field, &processes.ProcessWorkItemTypeFieldRequest{
+		DefaultValue: model.DefaultValue,
+		ReadOnly:     model.ReadOnly.ValueBoolPointer(),
+		Required:     model.Required.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update the field of the work item type", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ProcessWorkItemTypeFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *ProcessWorkItemTypeFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveWorkItemTypeField(ctx, model.ProcessId, model.WorkItemType, model.Field)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove field from work item type", err.Error())
+	}
+}
+
+func (r *ProcessWorkItemTypeFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "processId/workItemType/field")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	processId, err := getProcessId(ctx, r.client, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find process '%s'", parts[0]), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ProcessWorkItemTypeFieldResourceModel{
+		Field:        parts[2],
+		ProcessId:    processId,
+		WorkItemType: parts[1],
+	})...)
+}
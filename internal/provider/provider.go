@@ -2,23 +2,47 @@ package provider
 
 import (
 	"context"
-	"encoding/base64"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/git"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/graph"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/pipelines"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/processes"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/serviceendpoints"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/work"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/workitems"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"net/url"
+	"time"
+)
+
+const (
+	envGraphUrl        = "AZDO_GRAPH_URL"
+	envIdentityUrl     = "AZDO_IDENTITY_URL"
+	envOrganizationUrl = "AZDO_ORG_SERVICE_URL"
 )
 
 var _ provider.Provider = &AzureDevOpsProvider{}
 
 type AzureDevOpsProvider struct {
+	// cassetteMode and cassettePath record the interactions of the provider
+	// to a cassette, or replay them from it, instead of reading the
+	// AZDO_CASSETTE_MODE and AZDO_CASSETTE_PATH environment variables.
+	cassetteMode networking.CassetteMode
+	cassettePath string
+
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
@@ -26,8 +50,41 @@ type AzureDevOpsProvider struct {
 }
 
 type AzureDevOpsProviderModel struct {
-	OrganizationUrl     string `tfsdk:"organization_url"`
-	PersonalAccessToken string `tfsdk:"personal_access_token"`
+	ApiVersions               map[string]string `tfsdk:"api_versions"`
+	AuthMethod                *string           `tfsdk:"auth_method"`
+	AuthorityHost             *string           `tfsdk:"authority_host"`
+	CaCertificate             *string           `tfsdk:"ca_certificate"`
+	CaCertificatePath         *string           `tfsdk:"ca_certificate_path"`
+	CheckScopes               *bool             `tfsdk:"check_scopes"`
+	ClientCertificate         *string           `tfsdk:"client_certificate"`
+	ClientCertificatePath     *string           `tfsdk:"client_certificate_path"`
+	ClientId                  *string           `tfsdk:"client_id"`
+	ClientSecret              *string           `tfsdk:"client_secret"`
+	GraphUrl                  *string           `tfsdk:"graph_url"`
+	IdentityUrl               *string           `tfsdk:"identity_url"`
+	MaxConcurrentRequests     *int64            `tfsdk:"max_concurrent_requests"`
+	MaxConnectionsPerHost     *int64            `tfsdk:"max_connections_per_host"`
+	MaxIdleConnections        *int64            `tfsdk:"max_idle_connections"`
+	MaxRetries                *int64            `tfsdk:"max_retries"`
+	MaxRetryWait              *int64            `tfsdk:"max_retry_wait"`
+	MsiEndpoint               *string           `tfsdk:"msi_endpoint"`
+	OidcRequestToken          *string           `tfsdk:"oidc_request_token"`
+	OidcRequestUrl            *string           `tfsdk:"oidc_request_url"`
+	OidcServiceConnectionId   *string           `tfsdk:"oidc_service_connection_id"`
+	OidcToken                 *string           `tfsdk:"oidc_token"`
+	OidcTokenFilePath         *string           `tfsdk:"oidc_token_file_path"`
+	OrganizationUrl           *string           `tfsdk:"organization_url"`
+	PersonalAccessToken       *string           `tfsdk:"personal_access_token"`
+	PersonalAccessTokenFile   *string           `tfsdk:"personal_access_token_file"`
+	ProxyUrl                  *string           `tfsdk:"proxy_url"`
+	RequestTimeout            *int64            `tfsdk:"request_timeout"`
+	SkipCredentialsValidation *bool             `tfsdk:"skip_credentials_validation"`
+	TenantId                  *string           `tfsdk:"tenant_id"`
+	TlsClientCertificatePath  *string           `tfsdk:"tls_client_certificate_path"`
+	TlsClientKeyPath          *string           `tfsdk:"tls_client_key_path"`
+	TlsInsecureSkipVerify     *bool             `tfsdk:"tls_insecure_skip_verify"`
+	UseMsi                    *bool             `tfsdk:"use_msi"`
+	UseOidc                   *bool             `tfsdk:"use_oidc"`
 }
 
 func (p *AzureDevOpsProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -38,15 +95,176 @@ func (p *AzureDevOpsProvider) Metadata(_ context.Context, _ provider.MetadataReq
 func (p *AzureDevOpsProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"api_versions": schema.MapAttribute{
+				MarkdownDescription: "The versions of the REST APIs to use, keyed by area (the path segment following `_apis`, e.g. `graph` or `wit`). By default, each call uses the highest version supported by the organization up to the version the provider was written against. Pin a version when an API regresses.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(validators.ApiVersion()),
+				},
+			},
+			"auth_method": schema.StringAttribute{
+				MarkdownDescription: "The authentication method to use. Must be `pat`, `client_secret`, `client_certificate`, `oidc` or `msi`. By default, it is inferred from the configured credentials. Can also be set with the `" + envAuthMethod + "` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(authMethods...),
+				},
+			},
+			"authority_host": schema.StringAttribute{
+				MarkdownDescription: "The Entra ID authority host used to acquire access tokens, e.g. `https://login.microsoftonline.us/` for Azure Government or `https://login.chinacloudapi.cn/` for Azure China. Defaults to `" + networking.DefaultAuthorityHost + "`. Can also be set with the `" + envAuthorityHost + "` or `" + envAzureAuthorityHost + "` environment variables.",
+				Optional:            true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted on top of the system ones, e.g. the certificate of a TLS-inspecting proxy. Can also be set with the `" + envCaCertificate + "` environment variable.",
+				Optional:            true,
+			},
+			"ca_certificate_path": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM file containing CA certificates trusted on top of the system ones. Can also be set with the `" + envCaCertificatePath + "` environment variable.",
+				Optional:            true,
+			},
+			"check_scopes": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to warn when the provider is configured about the areas of the REST APIs the credentials are not allowed to call, e.g. because the personal access token lacks their scopes. Can also be set with the `" + envCheckScopes + "` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded certificate and private key of the service principal. Can also be set with the `" + envClientCertificate + "` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_certificate_path": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM file containing the certificate and private key of the service principal. Can also be set with the `" + envClientCertificatePath + "` environment variable.",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client ID of the service principal or of the user-assigned managed identity. Can also be set with the `" + envClientId + "` environment variable.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of the service principal. Can also be set with the `" + envClientSecret + "` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"graph_url": schema.StringAttribute{
+				MarkdownDescription: "The base url of the Graph APIs. By default, it is discovered from the resource areas of the organization. Can also be set with the `" + envGraphUrl + "` environment variable.",
+				Optional:            true,
+			},
+			"identity_url": schema.StringAttribute{
+				MarkdownDescription: "The base url of the Identities APIs. Defaults to the url of the Graph APIs. Can also be set with the `" + envIdentityUrl + "` environment variable.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of requests sent at the same time to the organization, shared by all the configurations of the provider targeting it. Defaults to `%d`.", networking.DefaultMaxConcurrentRequests),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_connections_per_host": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of connections per host. Defaults to `%d`.", networking.DefaultMaxConnectionsPerHost),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_idle_connections": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of idle connections kept in the connection pool. Defaults to `%d`.", networking.DefaultMaxIdleConnections),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of retries when a request is throttled or fails with a transient error. Defaults to `%d`. Set to `0` to disable retries.", networking.DefaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_wait": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum time in seconds to wait across all retries of a single request. Defaults to `%d`.", int64(networking.DefaultMaxRetryWait.Seconds())),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"msi_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The endpoint of the managed identity token service. Defaults to the instance metadata service. Can also be set with the `" + envMsiEndpoint + "` environment variable.",
+				Optional:            true,
+			},
+			"oidc_request_token": schema.StringAttribute{
+				MarkdownDescription: "The bearer token used to request an OIDC token from the CI/CD platform. Can also be set with the `" + envOidcRequestToken + "` environment variable, and defaults to the GitHub Actions or Azure Pipelines one.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oidc_request_url": schema.StringAttribute{
+				MarkdownDescription: "The URL used to request an OIDC token from the CI/CD platform. Can also be set with the `" + envOidcRequestUrl + "` environment variable, and defaults to the GitHub Actions or Azure Pipelines one.",
+				Optional:            true,
+			},
+			"oidc_service_connection_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Azure Pipelines service connection configured with workload identity federation. Required when requesting an OIDC token from Azure Pipelines. Can also be set with the `" + envOidcServiceConnectionId + "` environment variable.",
+				Optional:            true,
+			},
+			"oidc_token": schema.StringAttribute{
+				MarkdownDescription: "The OIDC token exchanged for an Entra ID access token. Can also be set with the `" + envOidcToken + "` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oidc_token_file_path": schema.StringAttribute{
+				MarkdownDescription: "The path to a file containing the OIDC token exchanged for an Entra ID access token. Can also be set with the `" + envOidcTokenFilePath + "` environment variable.",
+				Optional:            true,
+			},
 			"organization_url": schema.StringAttribute{
-				MarkdownDescription: "The url of the Azure DevOps instance which should be used.",
-				Required:            true,
+				MarkdownDescription: "The url of the Azure DevOps instance which should be used. Can also be set with the `" + envOrganizationUrl + "` environment variable.",
+				Optional:            true,
 			},
 			"personal_access_token": schema.StringAttribute{
-				MarkdownDescription: "The personal access token which should be used.",
-				Required:            true,
+				MarkdownDescription: "The personal access token which should be used. Can also be set with the `" + envPersonalAccessToken + "` environment variable.",
+				Optional:            true,
 				Sensitive:           true,
 			},
+			"personal_access_token_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a file containing the personal access token which should be used. Can also be set with the `" + envPersonalAccessTokenFilePath + "` environment variable.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The url of the HTTP(S) proxy. Defaults to the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables. Can also be set with the `" + envProxyUrl + "` environment variable.",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The timeout in seconds of a single request. Defaults to `%d`.", int64(networking.DefaultRequestTimeout.Seconds())),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to skip the validation of the credentials when the provider is configured. Can also be set with the `" + envSkipCredentialsValidation + "` environment variable.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The Entra ID tenant of the service principal. Can also be set with the `" + envTenantId + "` environment variable.",
+				Optional:            true,
+			},
+			"tls_client_certificate_path": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM file containing the certificate presented for mutual TLS. Can also be set with the `" + envTlsClientCertificatePath + "` environment variable.",
+				Optional:            true,
+			},
+			"tls_client_key_path": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM file containing the private key of the certificate presented for mutual TLS. Can also be set with the `" + envTlsClientKeyPath + "` environment variable.",
+				Optional:            true,
+			},
+			"tls_insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to skip the verification of the server certificate. Should only be used for testing.",
+				Optional:            true,
+			},
+			"use_msi": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to authenticate with a managed identity. Can also be set with the `" + envUseMsi + "` environment variable.",
+				Optional:            true,
+			},
+			"use_oidc": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to authenticate with workload identity federation (OIDC). Can also be set with the `" + envUseOidc + "` environment variable.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -60,16 +278,72 @@ func (p *AzureDevOpsProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	client := clients.NewAzureDevOpsClient(data.OrganizationUrl, "Basic "+base64.StdEncoding.EncodeToString([]byte(":"+data.PersonalAccessToken)), p.version)
+	organizationUrl := getString(data.OrganizationUrl, envOrganizationUrl)
+	if organizationUrl == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("organization_url"), "Missing organization url", "The provider cannot create the Azure DevOps client as the organization url is missing. Set the 'organization_url' attribute or the '"+envOrganizationUrl+"' environment variable.")
+	} else if u, err := url.Parse(organizationUrl); err != nil || u.Scheme == "" || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("organization_url"), "Invalid organization url", fmt.Sprintf("The organization url '%s' must be an absolute url, e.g. 'https://dev.azure.com/contoso'.", organizationUrl))
+	}
+
+	httpClient, err := getHttpClient(&data, p.cassetteMode, p.cassettePath)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure the HTTP client", err.Error())
+		return
+	}
+
+	tokenProvider, err := getTokenProvider(&data, httpClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure authentication", err.Error())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retryPolicy := networking.DefaultRetryPolicy()
+	if data.MaxRetries != nil {
+		retryPolicy.MaxRetries = int(*data.MaxRetries)
+	}
+	if data.MaxRetryWait != nil {
+		retryPolicy.MaxWait = time.Duration(*data.MaxRetryWait) * time.Second
+	}
+
+	maxConcurrentRequests := networking.DefaultMaxConcurrentRequests
+	if data.MaxConcurrentRequests != nil {
+		maxConcurrentRequests = int(*data.MaxConcurrentRequests)
+	}
+
+	client := clients.NewAzureDevOpsClient(ctx, &clients.AzureDevOpsClientConfig{
+		ApiVersions:           data.ApiVersions,
+		GraphUrl:              getString(data.GraphUrl, envGraphUrl),
+		HttpClient:            httpClient,
+		IdentityUrl:           getString(data.IdentityUrl, envIdentityUrl),
+		MaxConcurrentRequests: maxConcurrentRequests,
+		OrganizationUrl:       organizationUrl,
+		ProviderVersion:       p.version,
+		RetryPolicy:           retryPolicy,
+		TokenProvider:         tokenProvider,
+	})
+	if !getBool(data.SkipCredentialsValidation, envSkipCredentialsValidation) {
+		resp.Diagnostics.Append(validateCredentials(ctx, client, organizationUrl, getBool(data.CheckScopes, envCheckScopes))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
 func (p *AzureDevOpsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		core.NewDeletedProjectsDataSource,
+		core.NewFeaturesDataSource,
 		core.NewProcessDataSource,
 		core.NewProjectDataSource,
 		core.NewProjectFeaturesDataSource,
+		core.NewProjectPropertiesDataSource,
+		core.NewProjectsDataSource,
 		core.NewTeamDataSource,
 		core.NewTeamsDataSource,
 		graph.NewGroupDataSource,
@@ -87,10 +361,13 @@ func (p *AzureDevOpsProvider) Resources(_ context.Context) []func() resource.Res
 		core.NewProjectResource,
 		core.NewProjectFeaturesResource,
 		core.NewProjectPermissionsResource,
+		core.NewProjectPropertiesResource,
 		core.NewTeamResource,
 		git.NewGitPermissionsResource,
 		graph.NewGroupResource,
 		graph.NewGroupMembershipResource,
+		graph.NewTeamAdministratorsResource,
+		graph.NewTeamMembersResource,
 		pipelines.NewAgentPoolResource,
 		pipelines.NewAgentQueueResource,
 		pipelines.NewEnvironmentResource,
@@ -98,6 +375,16 @@ func (p *AzureDevOpsProvider) Resources(_ context.Context) []func() resource.Res
 		pipelines.NewEnvironmentPermissionsResource,
 		pipelines.NewPipelinePermissionsResource,
 		pipelines.NewPipelineSettingsResource,
+		processes.NewProcessResource,
+		processes.NewProcessControlResource,
+		processes.NewProcessFieldResource,
+		processes.NewProcessGroupResource,
+		processes.NewProcessPageResource,
+		processes.NewProcessPicklistResource,
+		processes.NewProcessRuleResource,
+		processes.NewProcessStateResource,
+		processes.NewProcessWorkItemTypeResource,
+		processes.NewProcessWorkItemTypeFieldResource,
 		serviceendpoints.NewServiceEndpointAzureRmResource,
 		serviceendpoints.NewServiceEndpointBitbucketResource,
 		serviceendpoints.NewServiceEndpointDockerRegistryResource,
@@ -111,6 +398,9 @@ func (p *AzureDevOpsProvider) Resources(_ context.Context) []func() resource.Res
 		serviceendpoints.NewServiceEndpointShareResource,
 		serviceendpoints.NewServiceEndpointSonarCloudResource,
 		serviceendpoints.NewServiceEndpointVsAppCenterResource,
+		work.NewTeamAreasResource,
+		work.NewTeamIterationsResource,
+		work.NewTeamSettingsResource,
 		workitems.NewAreaPermissionsResource,
 		workitems.NewAreaResource,
 		workitems.NewIterationPermissionsResource,
@@ -125,3 +415,15 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// NewWithCassette returns a provider recording its interactions to the cassette at path, or replaying them from it,
+// whatever the environment variables, e.g. for the acceptance tests replaying a cassette.
+func NewWithCassette(version string, mode networking.CassetteMode, path string) func() provider.Provider {
+	return func() provider.Provider {
+		return &AzureDevOpsProvider{
+			cassetteMode: mode,
+			cassettePath: path,
+			version:      version,
+		}
+	}
+}
@@ -93,10 +93,20 @@ func ReadPrincipalPermissions(ctx context.Context, namespaceId string, token str
 	var identityPermissions []*PrincipalPermissions
 	accessControlList := (*accessControlLists.Value)[0]
 
+	var descriptors []string
 	for _, ace := range *accessControlList.AcesDictionary {
-		identity, identityErr := securityClient.GetIdentityByDescriptor(ctx, *ace.Descriptor)
-		if identityErr != nil {
-			return nil, identityErr
+		descriptors = append(descriptors, *ace.Descriptor)
+	}
+
+	identities, identitiesErr := securityClient.GetIdentitiesByDescriptors(ctx, descriptors)
+	if identitiesErr != nil {
+		return nil, identitiesErr
+	}
+
+	for _, ace := range *accessControlList.AcesDictionary {
+		identity, ok := identities[*ace.Descriptor]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("Identity not found '%s'", *ace.Descriptor))
 		}
 
 		permissions := map[string]string{}
@@ -110,28 +120,14 @@ func ReadPrincipalPermissions(ctx context.Context, namespaceId string, token str
 			}
 		}
 
-		identityName := identity.ProviderDisplayName
-		properties := identity.Properties.(map[string]interface{})
-		schemaClassName := properties["SchemaClassName"].(map[string]interface{})["$value"].(string)
-		switch schemaClassName {
-		case "Group":
-			if strings.HasPrefix(*identity.SubjectDescriptor, "aadgp") {
-				name := properties["Account"].(map[string]interface{})["$value"].(string)
-				if mail, ok := properties["Mail"]; ok {
-					name = mail.(map[string]interface{})["$value"].(string)
-				}
-				identityName = &name
-			}
-		case "User":
-			account := properties["Account"].(map[string]interface{})["$value"].(string)
-			identityName = &account
-		default:
-			return nil, errors.New(fmt.Sprintf("Unknown schema class name '%s'.", schemaClassName))
+		identityName, err := GetPrincipalName(identity)
+		if err != nil {
+			return nil, err
 		}
 
 		identityPermissions = append(identityPermissions, &PrincipalPermissions{
 			PrincipalDescriptor: *ace.Descriptor,
-			PrincipalName:       *identityName,
+			PrincipalName:       identityName,
 			Permissions:         permissions,
 		})
 	}
@@ -139,6 +135,48 @@ func ReadPrincipalPermissions(ctx context.Context, namespaceId string, token str
 	return identityPermissions, nil
 }
 
+// GetPrincipalName returns the name used to reference an identity in the configuration: the account of users,
+// the mail or account of Entra ID groups and the display name of Azure DevOps groups.
+func GetPrincipalName(identity *security.Identity) (string, error) {
+	identityName := identity.ProviderDisplayName
+	properties := identity.Properties.(map[string]interface{})
+	schemaClassName := properties["SchemaClassName"].(map[string]interface{})["$value"].(string)
+	switch schemaClassName {
+	case "Group":
+		if strings.HasPrefix(*identity.SubjectDescriptor, "aadgp") {
+			name := properties["Account"].(map[string]interface{})["$value"].(string)
+			if mail, ok := properties["Mail"]; ok {
+				name = mail.(map[string]interface{})["$value"].(string)
+			}
+			identityName = &name
+		}
+	case "User":
+		account := properties["Account"].(map[string]interface{})["$value"].(string)
+		identityName = &account
+	default:
+		return "", errors.New(fmt.Sprintf("Unknown schema class name '%s'.", schemaClassName))
+	}
+
+	return *identityName, nil
+}
+
+// ImportPrincipalPermissions returns the permissions of a principal, matched case-insensitively on its name, so that
+// the exact name stored in the access control list is written to the state of imported resources.
+func ImportPrincipalPermissions(ctx context.Context, namespaceId string, token string, principalName string, securityClient *security.Client) (*PrincipalPermissions, error) {
+	permissions, err := ReadPrincipalPermissions(ctx, namespaceId, token, securityClient)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range permissions {
+		if strings.EqualFold(p.PrincipalName, principalName) {
+			return p, nil
+		}
+	}
+
+	return nil, errors.New(fmt.Sprintf("No permissions found for principal '%s'", principalName))
+}
+
 // Private Methods
 
 func getAccessControlEntry(ctx context.Context, namespace *security.SecurityNamespaceDescription, permission *PrincipalPermissions, securityClient *security.Client, graphClient *graph.Client) (*security.AccessControlEntry, error) {
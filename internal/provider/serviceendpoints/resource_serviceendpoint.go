@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
@@ -16,7 +18,13 @@ import (
 	"time"
 )
 
-func CreateResourceServiceEndpoint(ctx context.Context, projectId string, args *serviceendpoints.CreateOrUpdateServiceEndpointArgs, serviceEndpointsClient *serviceendpoints.Client, pipelinesClient *pipelines.Client, resp *resource.CreateResponse) (*serviceendpoints.ServiceEndpoint, error) {
+func CreateResourceServiceEndpoint(ctx context.Context, projectId string, args *serviceendpoints.CreateOrUpdateServiceEndpointArgs, resourceTimeouts timeouts.Value, serviceEndpointsClient *serviceendpoints.Client, pipelinesClient *pipelines.Client, resp *resource.CreateResponse) (*serviceendpoints.ServiceEndpoint, error) {
+	timeout, diags := resourceTimeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil, errors.New("invalid create timeout")
+	}
+
 	serviceEndpoint, err := serviceEndpointsClient.CreateServiceEndpoint(ctx, args, projectId)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create service endpoint", err.Error())
@@ -46,7 +54,7 @@ func CreateResourceServiceEndpoint(ctx context.Context, projectId string, args *
 		Pending:    []string{"InProgress"},
 		Target:     []string{"Ready", "Failed"},
 		Refresh:    stateRefreshFunc,
-		Timeout:    30 * time.Second,
+		Timeout:    timeout,
 	}
 
 	readyServiceEndpoint, err := stateConf.WaitForStateContext(ctx)
@@ -65,7 +73,16 @@ func CreateResourceServiceEndpoint(ctx context.Context, projectId string, args *
 	return serviceEndpoint, nil
 }
 
-func ReadResourceServiceEndpoint(ctx context.Context, id string, projectId string, serviceEndpointsClient *serviceendpoints.Client, pipelinesClient *pipelines.Client, resp *resource.ReadResponse) (*serviceendpoints.ServiceEndpoint, bool, error) {
+func ReadResourceServiceEndpoint(ctx context.Context, id string, projectId string, resourceTimeouts timeouts.Value, serviceEndpointsClient *serviceendpoints.Client, pipelinesClient *pipelines.Client, resp *resource.ReadResponse) (*serviceendpoints.ServiceEndpoint, bool, error) {
+	timeout, diags := resourceTimeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil, false, errors.New("invalid read timeout")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	serviceEndpoint, err := serviceEndpointsClient.GetServiceEndpoint(ctx, id, projectId)
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
@@ -95,7 +112,25 @@ func ReadResourceServiceEndpoint(ctx context.Context, id string, projectId strin
 	return serviceEndpoint, authorized, nil
 }
 
-func UpdateResourceServiceEndpoint(ctx context.Context, id string, projectId string, args *serviceendpoints.CreateOrUpdateServiceEndpointArgs, serviceEndpointsClient *serviceendpoints.Client, pipelinesClient *pipelines.Client, resp *resource.UpdateResponse) (*serviceendpoints.ServiceEndpoint, error) {
+// ReadServiceEndpointDescription returns the description of a service endpoint, which Azure DevOps returns empty when not
+// set, kept null when it is not configured.
+func ReadServiceEndpointDescription(description *string, serviceEndpoint *serviceendpoints.ServiceEndpoint) *string {
+	if serviceEndpoint.Description == nil || *serviceEndpoint.Description == "" {
+		return utils.IfThenElse[*string](description == nil, nil, utils.EmptyString)
+	}
+	return serviceEndpoint.Description
+}
+
+func UpdateResourceServiceEndpoint(ctx context.Context, id string, projectId string, args *serviceendpoints.CreateOrUpdateServiceEndpointArgs, resourceTimeouts timeouts.Value, serviceEndpointsClient *serviceendpoints.Client, pipelinesClient *pipelines.Client, resp *resource.UpdateResponse) (*serviceendpoints.ServiceEndpoint, error) {
+	timeout, diags := resourceTimeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil, errors.New("invalid update timeout")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	serviceEndpoint, err := serviceEndpointsClient.UpdateServiceEndpoint(ctx, id, args, projectId)
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
@@ -116,14 +151,39 @@ func UpdateResourceServiceEndpoint(ctx context.Context, id string, projectId str
 	return serviceEndpoint, nil
 }
 
-func DeleteResourceServiceEndpoint(ctx context.Context, id string, projectId string, serviceEndpointsClient *serviceendpoints.Client, resp *resource.DeleteResponse) {
+func DeleteResourceServiceEndpoint(ctx context.Context, id string, projectId string, resourceTimeouts timeouts.Value, serviceEndpointsClient *serviceendpoints.Client, resp *resource.DeleteResponse) {
+	timeout, diags := resourceTimeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	err := serviceEndpointsClient.DeleteServiceEndpoint(ctx, id, []string{projectId})
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Service connection with Id '%s' failed to delete", id), err.Error())
 	}
 }
 
-func GetServiceEndpointResourceSchemaBase(description string) schema.Schema {
+func ImportResourceServiceEndpoint(ctx context.Context, id string, coreClient *core.Client, resp *resource.ImportStateResponse) (string, string, error) {
+	parts, err := utils.ParseImportId(id, "projectId/serviceEndpointId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return "", "", err
+	}
+
+	projectId, err := coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return "", "", err
+	}
+
+	return projectId, parts[1], nil
+}
+
+func GetServiceEndpointResourceSchemaBase(ctx context.Context, description string) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: description,
 		Attributes: map[string]schema.Attribute{
@@ -157,5 +217,8 @@ func GetServiceEndpointResourceSchemaBase(description string) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
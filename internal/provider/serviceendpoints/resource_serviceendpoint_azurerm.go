@@ -2,45 +2,51 @@ package serviceendpoints
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 )
 
 var _ resource.Resource = &ServiceEndpointAzureRmResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointAzureRmResource{}
 
 func NewServiceEndpointAzureRmResource() resource.Resource {
 	return &ServiceEndpointAzureRmResource{}
 }
 
 type ServiceEndpointAzureRmResource struct {
+	coreClient             *core.Client
 	pipelinesClient        *pipelines.Client
 	serviceEndpointsClient *serviceendpoints.Client
 }
 
 type ServiceEndpointAzureRmResourceModel struct {
-	Description         *string      `tfsdk:"description"`
-	Id                  types.String `tfsdk:"id"`
-	GrantAllPipelines   bool         `tfsdk:"grant_all_pipelines"`
-	Name                string       `tfsdk:"name"`
-	ProjectId           string       `tfsdk:"project_id"`
-	ServicePrincipalId  string       `tfsdk:"service_principal_id"`
-	ServicePrincipalKey string       `tfsdk:"service_principal_key"`
-	SubscriptionId      string       `tfsdk:"subscription_id"`
-	SubscriptionName    string       `tfsdk:"subscription_name"`
-	TenantId            string       `tfsdk:"tenant_id"`
+	Description         *string        `tfsdk:"description"`
+	GrantAllPipelines   bool           `tfsdk:"grant_all_pipelines"`
+	Id                  types.String   `tfsdk:"id"`
+	Name                string         `tfsdk:"name"`
+	ProjectId           string         `tfsdk:"project_id"`
+	ServicePrincipalId  string         `tfsdk:"service_principal_id"`
+	ServicePrincipalKey string         `tfsdk:"service_principal_key"`
+	SubscriptionId      string         `tfsdk:"subscription_id"`
+	SubscriptionName    string         `tfsdk:"subscription_name"`
+	TenantId            string         `tfsdk:"tenant_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ServiceEndpointAzureRmResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_serviceendpoint_azurerm"
 }
 
-func (r *ServiceEndpointAzureRmResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resourceShema := GetServiceEndpointResourceSchemaBase("Manages an AzureRM service endpoint within an Azure DevOps project.")
+func (r *ServiceEndpointAzureRmResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceShema := GetServiceEndpointResourceSchemaBase(ctx, "Manages an AzureRM service endpoint within an Azure DevOps project.")
 	resourceShema.Attributes["service_principal_id"] = schema.StringAttribute{
 		MarkdownDescription: "The ID of the service principal.",
 		Required:            true,
@@ -71,6 +77,7 @@ func (r *ServiceEndpointAzureRmResource) Configure(_ context.Context, req resour
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.pipelinesClient = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
 	r.serviceEndpointsClient = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
 }
@@ -83,7 +90,7 @@ func (r *ServiceEndpointAzureRmResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -101,12 +108,12 @@ func (r *ServiceEndpointAzureRmResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
 
-	model.Description = utils.IfThenElse[*string](serviceEndpoint.Description != nil, model.Description, utils.EmptyString)
+	model.Description = ReadServiceEndpointDescription(model.Description, serviceEndpoint)
 	model.GrantAllPipelines = granted
 	model.Name = *serviceEndpoint.Name
 	model.ServicePrincipalId = (*serviceEndpoint.Authorization.Parameters)[serviceendpoints.ServiceEndpointAuthorizationParamsServicePrincipalId]
@@ -125,7 +132,7 @@ func (r *ServiceEndpointAzureRmResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -141,7 +148,21 @@ func (r *ServiceEndpointAzureRmResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, resp)
+	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, resp)
+}
+
+func (r *ServiceEndpointAzureRmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	model := &ServiceEndpointAzureRmResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Private Methods
@@ -2,42 +2,48 @@ package serviceendpoints
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 )
 
 var _ resource.Resource = &ServiceEndpointBitbucketResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointBitbucketResource{}
 
 func NewServiceEndpointBitbucketResource() resource.Resource {
 	return &ServiceEndpointBitbucketResource{}
 }
 
 type ServiceEndpointBitbucketResource struct {
+	coreClient             *core.Client
 	pipelinesClient        *pipelines.Client
 	serviceEndpointsClient *serviceendpoints.Client
 }
 
 type ServiceEndpointBitbucketResourceModel struct {
-	Description       *string      `tfsdk:"description"`
-	Id                types.String `tfsdk:"id"`
-	GrantAllPipelines bool         `tfsdk:"grant_all_pipelines"`
-	Name              string       `tfsdk:"name"`
-	Password          string       `tfsdk:"password"`
-	ProjectId         string       `tfsdk:"project_id"`
-	UserName          string       `tfsdk:"username"`
+	Description       *string        `tfsdk:"description"`
+	GrantAllPipelines bool           `tfsdk:"grant_all_pipelines"`
+	Id                types.String   `tfsdk:"id"`
+	Name              string         `tfsdk:"name"`
+	Password          string         `tfsdk:"password"`
+	ProjectId         string         `tfsdk:"project_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	UserName          string         `tfsdk:"username"`
 }
 
 func (r *ServiceEndpointBitbucketResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_serviceendpoint_bitbucket"
 }
 
-func (r *ServiceEndpointBitbucketResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resourceShema := GetServiceEndpointResourceSchemaBase("Manages a Bitbucket service endpoint within an Azure DevOps project.")
+func (r *ServiceEndpointBitbucketResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceShema := GetServiceEndpointResourceSchemaBase(ctx, "Manages a Bitbucket service endpoint within an Azure DevOps project.")
 	resourceShema.Attributes["password"] = schema.StringAttribute{
 		MarkdownDescription: "Bitbucket account password.",
 		Required:            true,
@@ -56,6 +62,7 @@ func (r *ServiceEndpointBitbucketResource) Configure(_ context.Context, req reso
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.pipelinesClient = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
 	r.serviceEndpointsClient = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
 }
@@ -68,7 +75,7 @@ func (r *ServiceEndpointBitbucketResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -86,12 +93,12 @@ func (r *ServiceEndpointBitbucketResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
 
-	model.Description = utils.IfThenElse[*string](serviceEndpoint.Description != nil, model.Description, utils.EmptyString)
+	model.Description = ReadServiceEndpointDescription(model.Description, serviceEndpoint)
 	model.GrantAllPipelines = granted
 	model.Name = *serviceEndpoint.Name
 
@@ -106,7 +113,7 @@ func (r *ServiceEndpointBitbucketResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -122,7 +129,21 @@ func (r *ServiceEndpointBitbucketResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, resp)
+	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, resp)
+}
+
+func (r *ServiceEndpointBitbucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	model := &ServiceEndpointBitbucketResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Private Methods
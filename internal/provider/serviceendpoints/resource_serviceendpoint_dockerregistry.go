@@ -2,11 +2,14 @@ package serviceendpoints
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
@@ -14,33 +17,36 @@ import (
 )
 
 var _ resource.Resource = &ServiceEndpointDockerRegistryResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointDockerRegistryResource{}
 
 func NewServiceEndpointDockerRegistryResource() resource.Resource {
 	return &ServiceEndpointDockerRegistryResource{}
 }
 
 type ServiceEndpointDockerRegistryResource struct {
+	coreClient             *core.Client
 	pipelinesClient        *pipelines.Client
 	serviceEndpointsClient *serviceendpoints.Client
 }
 
 type ServiceEndpointDockerRegistryResourceModel struct {
-	Description       *string      `tfsdk:"description"`
-	GrantAllPipelines bool         `tfsdk:"grant_all_pipelines"`
-	Id                types.String `tfsdk:"id"`
-	Name              string       `tfsdk:"name"`
-	Password          string       `tfsdk:"password"`
-	ProjectId         string       `tfsdk:"project_id"`
-	URL               string       `tfsdk:"url"`
-	Username          string       `tfsdk:"username"`
+	Description       *string        `tfsdk:"description"`
+	GrantAllPipelines bool           `tfsdk:"grant_all_pipelines"`
+	Id                types.String   `tfsdk:"id"`
+	Name              string         `tfsdk:"name"`
+	Password          string         `tfsdk:"password"`
+	ProjectId         string         `tfsdk:"project_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	URL               string         `tfsdk:"url"`
+	Username          string         `tfsdk:"username"`
 }
 
 func (r *ServiceEndpointDockerRegistryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_serviceendpoint_dockerregistry"
 }
 
-func (r *ServiceEndpointDockerRegistryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resourceShema := GetServiceEndpointResourceSchemaBase("Manages a Docker Registry service endpoint within an Azure DevOps project.")
+func (r *ServiceEndpointDockerRegistryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceShema := GetServiceEndpointResourceSchemaBase(ctx, "Manages a Docker Registry service endpoint within an Azure DevOps project.")
 	resourceShema.Attributes["password"] = schema.StringAttribute{
 		MarkdownDescription: "Docker registry password.",
 		Required:            true,
@@ -72,6 +78,7 @@ func (r *ServiceEndpointDockerRegistryResource) Configure(_ context.Context, req
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.pipelinesClient = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
 	r.serviceEndpointsClient = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
 }
@@ -84,7 +91,7 @@ func (r *ServiceEndpointDockerRegistryResource) Create(ctx context.Context, req
 		return
 	}
 
-	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -102,12 +109,12 @@ func (r *ServiceEndpointDockerRegistryResource) Read(ctx context.Context, req re
 		return
 	}
 
-	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
 
-	model.Description = utils.IfThenElse[*string](serviceEndpoint.Description != nil, model.Description, utils.EmptyString)
+	model.Description = ReadServiceEndpointDescription(model.Description, serviceEndpoint)
 	model.GrantAllPipelines = granted
 	model.Name = *serviceEndpoint.Name
 
@@ -122,7 +129,7 @@ func (r *ServiceEndpointDockerRegistryResource) Update(ctx context.Context, req
 		return
 	}
 
-	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -138,7 +145,21 @@ func (r *ServiceEndpointDockerRegistryResource) Delete(ctx context.Context, req
 		return
 	}
 
-	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, resp)
+	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, resp)
+}
+
+func (r *ServiceEndpointDockerRegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	model := &ServiceEndpointDockerRegistryResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Private Methods
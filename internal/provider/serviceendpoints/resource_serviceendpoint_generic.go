@@ -2,11 +2,14 @@ package serviceendpoints
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
@@ -14,33 +17,36 @@ import (
 )
 
 var _ resource.Resource = &ServiceEndpointGenericResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointGenericResource{}
 
 func NewServiceEndpointGenericResource() resource.Resource {
 	return &ServiceEndpointGenericResource{}
 }
 
 type ServiceEndpointGenericResource struct {
+	coreClient             *core.Client
 	pipelinesClient        *pipelines.Client
 	serviceEndpointsClient *serviceendpoints.Client
 }
 
 type ServiceEndpointGenericResourceModel struct {
-	Description       *string      `tfsdk:"description"`
-	GrantAllPipelines bool         `tfsdk:"grant_all_pipelines"`
-	Id                types.String `tfsdk:"id"`
-	Name              string       `tfsdk:"name"`
-	Password          types.String `tfsdk:"password"`
-	ProjectId         string       `tfsdk:"project_id"`
-	URL               string       `tfsdk:"url"`
-	Username          types.String `tfsdk:"username"`
+	Description       *string        `tfsdk:"description"`
+	GrantAllPipelines bool           `tfsdk:"grant_all_pipelines"`
+	Id                types.String   `tfsdk:"id"`
+	Name              string         `tfsdk:"name"`
+	Password          types.String   `tfsdk:"password"`
+	ProjectId         string         `tfsdk:"project_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	URL               string         `tfsdk:"url"`
+	Username          types.String   `tfsdk:"username"`
 }
 
 func (r *ServiceEndpointGenericResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_serviceendpoint_generic"
 }
 
-func (r *ServiceEndpointGenericResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resourceShema := GetServiceEndpointResourceSchemaBase("Manages a generic service endpoint within an Azure DevOps project, which can be used to authenticate to any external server using basic authentication via a username and password.")
+func (r *ServiceEndpointGenericResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceShema := GetServiceEndpointResourceSchemaBase(ctx, "Manages a generic service endpoint within an Azure DevOps project, which can be used to authenticate to any external server using basic authentication via a username and password.")
 	resourceShema.Attributes["password"] = schema.StringAttribute{
 		MarkdownDescription: "The password or token key used to authenticate to the server url using basic authentication.",
 		Optional:            true,
@@ -72,6 +78,7 @@ func (r *ServiceEndpointGenericResource) Configure(_ context.Context, req resour
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.pipelinesClient = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
 	r.serviceEndpointsClient = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
 }
@@ -84,7 +91,7 @@ func (r *ServiceEndpointGenericResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -102,14 +109,17 @@ func (r *ServiceEndpointGenericResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
 
-	model.Description = utils.IfThenElse[*string](serviceEndpoint.Description != nil, model.Description, utils.EmptyString)
+	model.Description = ReadServiceEndpointDescription(model.Description, serviceEndpoint)
 	model.GrantAllPipelines = granted
 	model.Name = *serviceEndpoint.Name
+	if serviceEndpoint.Url != nil {
+		model.URL = *serviceEndpoint.Url
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
@@ -122,7 +132,7 @@ func (r *ServiceEndpointGenericResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -138,7 +148,21 @@ func (r *ServiceEndpointGenericResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, resp)
+	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, resp)
+}
+
+func (r *ServiceEndpointGenericResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	model := &ServiceEndpointGenericResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Private Methods
@@ -0,0 +1,90 @@
+package serviceendpoints_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"testing"
+)
+
+func TestAccServiceEndpointGenericResource(t *testing.T) {
+	// The service endpoint stays pending for a few polls, like the endpoints verified by Azure DevOps
+	server := acctest.NewServer(t, &fakeserver.ServerConfig{PendingPolls: 2})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServiceEndpointDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointGenericConfig(server.URL, "Generic-API", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("azuredevops_serviceendpoint_generic.test", "id"),
+					resource.TestCheckResourceAttr("azuredevops_serviceendpoint_generic.test", "grant_all_pipelines", "true"),
+					resource.TestCheckResourceAttr("azuredevops_serviceendpoint_generic.test", "name", "Generic-API"),
+				),
+			},
+			{
+				Config: testAccServiceEndpointGenericConfig(server.URL, "Generic-API-Renamed", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_serviceendpoint_generic.test", "grant_all_pipelines", "false"),
+					resource.TestCheckResourceAttr("azuredevops_serviceendpoint_generic.test", "name", "Generic-API-Renamed"),
+				),
+			},
+			{
+				ResourceName: "azuredevops_serviceendpoint_generic.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "Sandbox/" + s.RootModule().Resources["azuredevops_serviceendpoint_generic.test"].Primary.ID, nil
+				},
+				ImportStateVerify: true,
+				// Secrets are not returned by Azure DevOps
+				ImportStateVerifyIgnore: []string{"password", "timeouts", "username"},
+			},
+		},
+	})
+}
+
+func testAccCheckServiceEndpointDestroyed(server *fakeserver.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.NewClient(server)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "azuredevops_serviceendpoint_generic" {
+				continue
+			}
+
+			serviceEndpoint, err := client.ServiceEndpointsClient.GetServiceEndpoint(context.Background(), rs.Primary.ID, rs.Primary.Attributes["project_id"])
+			if err == nil && serviceEndpoint != nil {
+				return fmt.Errorf("service endpoint '%s' still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccServiceEndpointGenericConfig(organizationUrl string, name string, grantAllPipelines bool) string {
+	return acctest.ProviderConfig(organizationUrl) + fmt.Sprintf(`
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_project" "test" {
+  name                = "Sandbox"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Git"
+  visibility          = "private"
+}
+
+resource "azuredevops_serviceendpoint_generic" "test" {
+  description         = "Managed by Terraform"
+  grant_all_pipelines = %t
+  name                = %q
+  password            = "secret"
+  project_id          = azuredevops_project.test.id
+  url                 = "https://server.contoso.com/"
+  username            = "username"
+}
+`, grantAllPipelines, name)
+}
@@ -2,11 +2,14 @@ package serviceendpoints
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
@@ -14,31 +17,34 @@ import (
 )
 
 var _ resource.Resource = &ServiceEndpointGitHubResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointGitHubResource{}
 
 func NewServiceEndpointGitHubResource() resource.Resource {
 	return &ServiceEndpointGitHubResource{}
 }
 
 type ServiceEndpointGitHubResource struct {
+	coreClient             *core.Client
 	pipelinesClient        *pipelines.Client
 	serviceEndpointsClient *serviceendpoints.Client
 }
 
 type ServiceEndpointGitHubResourceModel struct {
-	AccessToken       string       `tfsdk:"access_token"`
-	Description       *string      `tfsdk:"description"`
-	GrantAllPipelines bool         `tfsdk:"grant_all_pipelines"`
-	Id                types.String `tfsdk:"id"`
-	Name              string       `tfsdk:"name"`
-	ProjectId         string       `tfsdk:"project_id"`
+	AccessToken       string         `tfsdk:"access_token"`
+	Description       *string        `tfsdk:"description"`
+	GrantAllPipelines bool           `tfsdk:"grant_all_pipelines"`
+	Id                types.String   `tfsdk:"id"`
+	Name              string         `tfsdk:"name"`
+	ProjectId         string         `tfsdk:"project_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ServiceEndpointGitHubResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_serviceendpoint_github"
 }
 
-func (r *ServiceEndpointGitHubResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resourceShema := GetServiceEndpointResourceSchemaBase("Manages a GitHub service endpoint within an Azure DevOps project.")
+func (r *ServiceEndpointGitHubResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceShema := GetServiceEndpointResourceSchemaBase(ctx, "Manages a GitHub service endpoint within an Azure DevOps project.")
 	resourceShema.Attributes["access_token"] = schema.StringAttribute{
 		MarkdownDescription: "GitHub personal access token.",
 		Required:            true,
@@ -55,6 +61,7 @@ func (r *ServiceEndpointGitHubResource) Configure(_ context.Context, req resourc
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.pipelinesClient = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
 	r.serviceEndpointsClient = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
 }
@@ -67,7 +74,7 @@ func (r *ServiceEndpointGitHubResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -85,12 +92,12 @@ func (r *ServiceEndpointGitHubResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
 
-	model.Description = utils.IfThenElse[*string](serviceEndpoint.Description != nil, model.Description, utils.EmptyString)
+	model.Description = ReadServiceEndpointDescription(model.Description, serviceEndpoint)
 	model.GrantAllPipelines = granted
 	model.Name = *serviceEndpoint.Name
 
@@ -105,7 +112,7 @@ func (r *ServiceEndpointGitHubResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -121,7 +128,21 @@ func (r *ServiceEndpointGitHubResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, resp)
+	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, resp)
+}
+
+func (r *ServiceEndpointGitHubResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	model := &ServiceEndpointGitHubResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Private Methods
@@ -2,6 +2,7 @@ package serviceendpoints
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
@@ -16,35 +18,38 @@ import (
 )
 
 var _ resource.Resource = &ServiceEndpointJFrogResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointJFrogResource{}
 
 func NewServiceEndpointJFrogResource() resource.Resource {
 	return &ServiceEndpointJFrogResource{}
 }
 
 type ServiceEndpointJFrogResource struct {
+	coreClient             *core.Client
 	pipelinesClient        *pipelines.Client
 	serviceEndpointsClient *serviceendpoints.Client
 }
 
 type ServiceEndpointJFrogResourceModel struct {
-	AccessToken       types.String `tfsdk:"access_token"`
-	Description       *string      `tfsdk:"description"`
-	Id                types.String `tfsdk:"id"`
-	GrantAllPipelines bool         `tfsdk:"grant_all_pipelines"`
-	Name              string       `tfsdk:"name"`
-	Password          types.String `tfsdk:"password"`
-	ProjectId         string       `tfsdk:"project_id"`
-	Service           string       `tfsdk:"service"`
-	Username          types.String `tfsdk:"username"`
-	URL               string       `tfsdk:"url"`
+	AccessToken       types.String   `tfsdk:"access_token"`
+	Description       *string        `tfsdk:"description"`
+	GrantAllPipelines bool           `tfsdk:"grant_all_pipelines"`
+	Id                types.String   `tfsdk:"id"`
+	Name              string         `tfsdk:"name"`
+	Password          types.String   `tfsdk:"password"`
+	ProjectId         string         `tfsdk:"project_id"`
+	Service           string         `tfsdk:"service"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	URL               string         `tfsdk:"url"`
+	Username          types.String   `tfsdk:"username"`
 }
 
 func (r *ServiceEndpointJFrogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_serviceendpoint_jfrog"
 }
 
-func (r *ServiceEndpointJFrogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resourceShema := GetServiceEndpointResourceSchemaBase("Manages a JFrog service endpoint within an Azure DevOps project. You need to install [JFrog Azure DevOps Extension](https://marketplace.visualstudio.com/items?itemName=JFrog.jfrog-azure-devops-extension) from the Marketplace.")
+func (r *ServiceEndpointJFrogResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceShema := GetServiceEndpointResourceSchemaBase(ctx, "Manages a JFrog service endpoint within an Azure DevOps project. You need to install [JFrog Azure DevOps Extension](https://marketplace.visualstudio.com/items?itemName=JFrog.jfrog-azure-devops-extension) from the Marketplace.")
 	resourceShema.Attributes["access_token"] = schema.StringAttribute{
 		MarkdownDescription: "Access Token with deploy permissions.",
 		Optional:            true,
@@ -94,6 +99,7 @@ func (r *ServiceEndpointJFrogResource) Configure(_ context.Context, req resource
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.pipelinesClient = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
 	r.serviceEndpointsClient = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
 }
@@ -106,7 +112,7 @@ func (r *ServiceEndpointJFrogResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -124,12 +130,12 @@ func (r *ServiceEndpointJFrogResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
 
-	model.Description = utils.IfThenElse[*string](serviceEndpoint.Description != nil, model.Description, utils.EmptyString)
+	model.Description = ReadServiceEndpointDescription(model.Description, serviceEndpoint)
 	model.GrantAllPipelines = granted
 	model.Name = *serviceEndpoint.Name
 
@@ -144,7 +150,7 @@ func (r *ServiceEndpointJFrogResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), r.serviceEndpointsClient, r.pipelinesClient, resp)
+	_, err := UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.getCreateOrUpdateServiceEndpointArgs(model), model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -160,7 +166,21 @@ func (r *ServiceEndpointJFrogResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, resp)
+	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, resp)
+}
+
+func (r *ServiceEndpointJFrogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	model := &ServiceEndpointJFrogResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Private Methods
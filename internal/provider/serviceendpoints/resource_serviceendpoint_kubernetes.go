@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/pipelines"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
@@ -15,12 +18,14 @@ import (
 )
 
 var _ resource.Resource = &ServiceEndpointKubernetesResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointKubernetesResource{}
 
 func NewServiceEndpointKubernetesResource() resource.Resource {
 	return &ServiceEndpointKubernetesResource{}
 }
 
 type ServiceEndpointKubernetesResource struct {
+	coreClient             *core.Client
 	pipelinesClient        *pipelines.Client
 	serviceEndpointsClient *serviceendpoints.Client
 }
@@ -32,6 +37,7 @@ type ServiceEndpointKubernetesResourceModel struct {
 	Kubeconfig        ServiceEndpointKubeconfig `tfsdk:"kubeconfig"`
 	Name              string                    `tfsdk:"name"`
 	ProjectId         string                    `tfsdk:"project_id"`
+	Timeouts          timeouts.Value            `tfsdk:"timeouts"`
 }
 
 type ServiceEndpointKubeconfig struct {
@@ -43,8 +49,8 @@ func (r *ServiceEndpointKubernetesResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_serviceendpoint_kubernetes"
 }
 
-func (r *ServiceEndpointKubernetesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resourceShema := GetServiceEndpointResourceSchemaBase("Manages a Kubernetes service endpoint within an Azure DevOps project.")
+func (r *ServiceEndpointKubernetesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceShema := GetServiceEndpointResourceSchemaBase(ctx, "Manages a Kubernetes service endpoint within an Azure DevOps project.")
 	resourceShema.Attributes["kubeconfig"] = schema.SingleNestedAttribute{
 		MarkdownDescription: "The information required to connect a cluster with a kubeconfig.",
 		Required:            true,
@@ -68,6 +74,7 @@ func (r *ServiceEndpointKubernetesResource) Configure(_ context.Context, req res
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.pipelinesClient = req.ProviderData.(*clients.AzureDevOpsClient).PipelinesClient
 	r.serviceEndpointsClient = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
 }
@@ -86,7 +93,7 @@ func (r *ServiceEndpointKubernetesResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, args, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, err := CreateResourceServiceEndpoint(ctx, model.ProjectId, args, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -104,12 +111,12 @@ func (r *ServiceEndpointKubernetesResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	serviceEndpoint, granted, err := ReadResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
 
-	model.Description = utils.IfThenElse[*string](serviceEndpoint.Description != nil, model.Description, utils.EmptyString)
+	model.Description = ReadServiceEndpointDescription(model.Description, serviceEndpoint)
 	model.GrantAllPipelines = granted
 	model.Name = *serviceEndpoint.Name
 
@@ -130,7 +137,7 @@ func (r *ServiceEndpointKubernetesResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	_, err = UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, args, r.serviceEndpointsClient, r.pipelinesClient, resp)
+	_, err = UpdateResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, args, model.Timeouts, r.serviceEndpointsClient, r.pipelinesClient, resp)
 	if err != nil {
 		return
 	}
@@ -146,7 +153,21 @@ func (r *ServiceEndpointKubernetesResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, r.serviceEndpointsClient, resp)
+	DeleteResourceServiceEndpoint(ctx, model.Id.ValueString(), model.ProjectId, model.Timeouts, r.serviceEndpointsClient, resp)
+}
+
+func (r *ServiceEndpointKubernetesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	model := &ServiceEndpointKubernetesResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	}
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Private Methods
@@ -2,6 +2,7 @@ package serviceendpoints
 
 import (
 	"context"
+	"fmt"
 	"github.com/ahmetb/go-linq/v3"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -10,9 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
 	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 )
 
@@ -25,12 +28,14 @@ const (
 )
 
 var _ resource.Resource = &ServiceEndpointPermissionsResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointPermissionsResource{}
 
 func NewServiceEndpointPermissionsResource() resource.Resource {
 	return &ServiceEndpointPermissionsResource{}
 }
 
 type ServiceEndpointPermissionsResource struct {
+	coreClient     *core.Client
 	graphClient    *graph.Client
 	securityClient *clientSecurity.Client
 }
@@ -134,6 +139,7 @@ func (r *ServiceEndpointPermissionsResource) Configure(_ context.Context, req re
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.graphClient = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
 	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
 }
@@ -216,6 +222,37 @@ func (r *ServiceEndpointPermissionsResource) Delete(ctx context.Context, req res
 	}
 }
 
+func (r *ServiceEndpointPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/principalName/serviceEndpointId", "projectId/principalName")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	model := &ServiceEndpointPermissionsResourceModel{ProjectId: projectId}
+	if len(parts) == 3 {
+		model.Id = types.StringValue(parts[2])
+	}
+
+	token := r.securityClient.GetServiceEndpointToken(projectId, model.Id.ValueString())
+	permissions, err := security.ImportPrincipalPermissions(ctx, clientSecurity.NamespaceIdServiceEndpoints, token, parts[1], r.securityClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve access control lists", err.Error())
+		return
+	}
+
+	model.PrincipalDescriptor = types.StringValue(permissions.PrincipalDescriptor)
+	model.PrincipalName = permissions.PrincipalName
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
 // Private Methods
 
 func (r *ServiceEndpointPermissionsResource) getPermissions(model *ServiceEndpointPermissionsResourceModel) *security.PrincipalPermissions {
@@ -8,19 +8,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/serviceendpoints"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 	"golang.org/x/exp/slices"
 )
 
 var _ resource.Resource = &ServiceEndpointShareResource{}
+var _ resource.ResourceWithImportState = &ServiceEndpointShareResource{}
 
 func NewServiceEndpointShareResource() resource.Resource {
 	return &ServiceEndpointShareResource{}
 }
 
 type ServiceEndpointShareResource struct {
-	client *serviceendpoints.Client
+	client     *serviceendpoints.Client
+	coreClient *core.Client
 }
 
 type ServiceEndpointShareResourceModel struct {
@@ -76,6 +79,7 @@ func (r *ServiceEndpointShareResource) Configure(_ context.Context, req resource
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).ServiceEndpointsClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *ServiceEndpointShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -157,6 +161,18 @@ func (r *ServiceEndpointShareResource) Delete(ctx context.Context, req resource.
 	}
 }
 
+func (r *ServiceEndpointShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, id, err := ImportResourceServiceEndpoint(ctx, req.ID, r.coreClient, resp)
+	if err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ServiceEndpointShareResourceModel{
+		Id:        types.StringValue(id),
+		ProjectId: projectId,
+	})...)
+}
+
 // Private Methods
 
 func (r *ServiceEndpointShareResource) createOrUpdateServiceEndpointShare(ctx context.Context, model *ServiceEndpointShareResourceModel) error {
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	envCaCertificate            = "AZDO_CA_CERTIFICATE"
+	envCaCertificatePath        = "AZDO_CA_CERTIFICATE_PATH"
+	envCassetteMode             = "AZDO_CASSETTE_MODE"
+	envCassettePath             = "AZDO_CASSETTE_PATH"
+	envProxyUrl                 = "AZDO_PROXY_URL"
+	envTlsClientCertificatePath = "AZDO_TLS_CLIENT_CERTIFICATE_PATH"
+	envTlsClientKeyPath         = "AZDO_TLS_CLIENT_KEY_PATH"
+)
+
+func getHttpClient(data *AzureDevOpsProviderModel, cassetteMode networking.CassetteMode, cassettePath string) (*http.Client, error) {
+	config := networking.DefaultHttpClientConfig()
+	config.CassetteMode = cassetteMode
+	config.CassettePath = cassettePath
+	if config.CassetteMode == "" {
+		config.CassetteMode = networking.CassetteMode(os.Getenv(envCassetteMode))
+		config.CassettePath = os.Getenv(envCassettePath)
+	}
+	config.InsecureSkipVerify = data.TlsInsecureSkipVerify != nil && *data.TlsInsecureSkipVerify
+	config.ProxyUrl = getString(data.ProxyUrl, envProxyUrl)
+	if data.MaxConnectionsPerHost != nil {
+		config.MaxConnectionsPerHost = int(*data.MaxConnectionsPerHost)
+	}
+	if data.MaxIdleConnections != nil {
+		config.MaxIdleConnections = int(*data.MaxIdleConnections)
+	}
+	if data.RequestTimeout != nil {
+		config.RequestTimeout = time.Duration(*data.RequestTimeout) * time.Second
+	}
+
+	caCertificates, err := getFileOrValue(getString(data.CaCertificate, envCaCertificate), getString(data.CaCertificatePath, envCaCertificatePath), "CA certificate")
+	if err != nil {
+		return nil, err
+	}
+	config.CaCertificates = caCertificates
+
+	clientCertificatePath := getString(data.TlsClientCertificatePath, envTlsClientCertificatePath)
+	clientKeyPath := getString(data.TlsClientKeyPath, envTlsClientKeyPath)
+	if clientCertificatePath != "" || clientKeyPath != "" {
+		if clientCertificatePath == "" || clientKeyPath == "" {
+			return nil, fmt.Errorf("both 'tls_client_certificate_path' and 'tls_client_key_path' are required to use a TLS client certificate")
+		}
+		if config.ClientCertificate, err = getFileOrValue("", clientCertificatePath, "TLS client certificate"); err != nil {
+			return nil, err
+		}
+		if config.ClientKey, err = getFileOrValue("", clientKeyPath, "TLS client key"); err != nil {
+			return nil, err
+		}
+	}
+
+	return networking.NewHttpClient(config)
+}
+
+// Private Methods
+
+func getFileOrValue(value string, path string, name string) ([]byte, error) {
+	if value != "" {
+		return []byte(value), nil
+	}
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s file '%s': %w", name, path, err)
+	}
+	return content, nil
+}
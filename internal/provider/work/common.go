@@ -0,0 +1,36 @@
+package work
+
+import (
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"strings"
+)
+
+const (
+	// Written by the users for the default iteration of a team, Azure DevOps returns it as '@currentIteration'
+	defaultIterationMacro = "@CurrentIteration"
+)
+
+// getRelativePath returns the path of an area or an iteration in the work APIs, relative to the root node of the
+// project like the paths of the classification node resources, e.g. 'Release 1/Sprint 1' for 'Project\Release 1\Sprint 1'.
+func getRelativePath(path string) string {
+	components := strings.Split(strings.TrimPrefix(path, "\\"), "\\")
+	return strings.Join(components[1:], "/")
+}
+
+// getWorkPath returns the path of a classification node in the work APIs, without the type of the node, e.g.
+// 'Project\Release 1' for '\Project\Iteration\Release 1'.
+func getWorkPath(node *workitems.WorkItemClassificationNode) string {
+	components := strings.Split(strings.TrimPrefix(*node.Path, "\\"), "\\")
+	return strings.Join(append(components[:1], components[2:]...), "\\")
+}
+
+// keepConfiguredPath returns the configured path when it matches the path read, so that a difference of case is not
+// reported as a change.
+func keepConfiguredPath(configuredPaths []string, path string) string {
+	for _, configuredPath := range configuredPaths {
+		if strings.EqualFold(configuredPath, path) {
+			return configuredPath
+		}
+	}
+	return path
+}
@@ -0,0 +1,268 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/work"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"strings"
+)
+
+var _ resource.Resource = &TeamAreasResource{}
+var _ resource.ResourceWithImportState = &TeamAreasResource{}
+
+func NewTeamAreasResource() resource.Resource {
+	return &TeamAreasResource{}
+}
+
+type TeamAreasResource struct {
+	client          *work.Client
+	coreClient      *core.Client
+	workItemsClient *workitems.Client
+}
+
+type TeamAreasResourceModel struct {
+	Areas       []TeamAreaModel `tfsdk:"areas"`
+	DefaultArea string          `tfsdk:"default_area"`
+	ProjectId   string          `tfsdk:"project_id"`
+	TeamId      string          `tfsdk:"team_id"`
+}
+
+type TeamAreaModel struct {
+	IncludeChildren bool   `tfsdk:"include_children"`
+	Path            string `tfsdk:"path"`
+}
+
+func (r *TeamAreasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_areas"
+}
+
+func (r *TeamAreasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the areas of a team within an Azure DevOps project, which select the work items shown to the team. A team must keep a default area, destroying this resource leaves the areas of the team unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"areas": schema.SetNestedAttribute{
+				MarkdownDescription: "The areas of the team. The default area must be one of them.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"include_children": schema.BoolAttribute{
+							MarkdownDescription: "Set to true to also select the work items of the children of the area.",
+							Required:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The path of the area, e.g. `Area 1/Child`. An empty path selects the root area of the project.",
+							Required:            true,
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"default_area": schema.StringAttribute{
+				MarkdownDescription: "The path of the area of the new work items created by the team, e.g. `Area 1`. An empty path selects the root area of the project.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamAreasResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).WorkClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+	r.workItemsClient = req.ProviderData.(*clients.AzureDevOpsClient).WorkItemsClient
+}
+
+func (r *TeamAreasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *TeamAreasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamAreas(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team areas", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamAreasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *TeamAreasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.coreClient.GetTeam(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the team", err.Error())
+		return
+	}
+
+	values, err := r.client.GetTeamFieldValues(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve team areas", err.Error())
+		return
+	}
+
+	readTeamAreas(values, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamAreasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *TeamAreasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamAreas(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team areas", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamAreasResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// A team must keep a default area, the areas are left as they are
+}
+
+func (r *TeamAreasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/teamId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	team, err := r.coreClient.GetTeam(ctx, projectId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find team '%s'", parts[1]), err.Error())
+		return
+	}
+
+	values, err := r.client.GetTeamFieldValues(ctx, projectId, team.Id.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve team areas", err.Error())
+		return
+	}
+
+	model := &TeamAreasResourceModel{ProjectId: projectId, TeamId: team.Id.String()}
+	readTeamAreas(values, model)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Private Methods
+
+func readTeamAreas(values *work.TeamFieldValues, model *TeamAreasResourceModel) {
+	var configuredPaths []string
+	for _, area := range model.Areas {
+		configuredPaths = append(configuredPaths, area.Path)
+	}
+
+	areas := []TeamAreaModel{}
+	if values.Values != nil {
+		for _, value := range *values.Values {
+			if value.Value == nil {
+				continue
+			}
+			areas = append(areas, TeamAreaModel{
+				IncludeChildren: value.IncludeChildren != nil && *value.IncludeChildren,
+				Path:            keepConfiguredPath(configuredPaths, getRelativePath(*value.Value)),
+			})
+		}
+	}
+	model.Areas = areas
+
+	if values.DefaultValue != nil {
+		model.DefaultArea = keepConfiguredPath([]string{model.DefaultArea}, getRelativePath(*values.DefaultValue))
+	}
+}
+
+func (r *TeamAreasResource) updateTeamAreas(ctx context.Context, model *TeamAreasResourceModel) error {
+	isDefaultAreaIncluded := false
+	var values []work.TeamFieldValue
+	for _, area := range model.Areas {
+		node, err := r.workItemsClient.GetArea(ctx, model.ProjectId, area.Path)
+		if err != nil {
+			return fmt.Errorf("unable to find area '%s': %s", area.Path, err.Error())
+		}
+
+		values = append(values, work.TeamFieldValue{
+			IncludeChildren: utils.Bool(area.IncludeChildren),
+			Value:           utils.String(getWorkPath(node)),
+		})
+		isDefaultAreaIncluded = isDefaultAreaIncluded || strings.EqualFold(area.Path, model.DefaultArea)
+	}
+
+	if !isDefaultAreaIncluded {
+		return fmt.Errorf("the default area '%s' must be one of the areas of the team", model.DefaultArea)
+	}
+
+	node, err := r.workItemsClient.GetArea(ctx, model.ProjectId, model.DefaultArea)
+	if err != nil {
+		return fmt.Errorf("unable to find area '%s': %s", model.DefaultArea, err.Error())
+	}
+
+	patch := &work.TeamFieldValuesPatch{
+		DefaultValue: utils.String(getWorkPath(node)),
+		Values:       &values,
+	}
+	_, err = r.client.UpdateTeamFieldValues(ctx, model.ProjectId, model.TeamId, patch)
+	return err
+}
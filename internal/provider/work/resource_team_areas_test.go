@@ -0,0 +1,112 @@
+package work_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"golang.org/x/exp/slices"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAccTeamAreasResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The areas are planned with the ID of an existing project
+				Config: testAccTeamConfig(server.URL),
+			},
+			{
+				Config: testAccTeamAreasConfig(server.URL, "azuredevops_area.test.path", `
+    {
+      include_children = true
+      path             = azuredevops_area.test.path
+    },
+    {
+      include_children = false
+      path             = ""
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_areas.test", "areas.#", "2"),
+					resource.TestCheckResourceAttr("azuredevops_team_areas.test", "default_area", "Developers"),
+					testAccCheckTeamAreas(server, "Sandbox\\Developers", "Sandbox:false", "Sandbox\\Developers:true"),
+				),
+			},
+			{
+				Config: testAccTeamAreasConfig(server.URL, `""`, `
+    {
+      include_children = true
+      path             = ""
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_areas.test", "areas.#", "1"),
+					resource.TestCheckResourceAttr("azuredevops_team_areas.test", "default_area", ""),
+					testAccCheckTeamAreas(server, "Sandbox", "Sandbox:true"),
+				),
+			},
+			{
+				ResourceName:                         "azuredevops_team_areas.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/Developers Team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
+			{
+				Config: testAccTeamAreasConfig(server.URL, "azuredevops_area.test.path", `
+    {
+      include_children = true
+      path             = ""
+    }`),
+				ExpectError: regexp.MustCompile("must be one of the areas of the team"),
+			},
+		},
+	})
+}
+
+// testAccCheckTeamAreas checks the default area of the team, and its areas formatted like 'Project\Area:true' where
+// the boolean tells whether the children of the area are included, sorted by path.
+func testAccCheckTeamAreas(server *fakeserver.Server, defaultArea string, areas ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		team := s.RootModule().Resources["azuredevops_team.test"].Primary
+		values, err := acctest.NewClient(server).WorkClient.GetTeamFieldValues(context.Background(), team.Attributes["project_id"], team.ID)
+		if err != nil {
+			return err
+		}
+
+		var actualAreas []string
+		for _, value := range *values.Values {
+			actualAreas = append(actualAreas, fmt.Sprintf("%s:%t", *value.Value, *value.IncludeChildren))
+		}
+		slices.Sort(actualAreas)
+		if *values.DefaultValue != defaultArea || strings.Join(actualAreas, ",") != strings.Join(areas, ",") {
+			return fmt.Errorf("expected the default area '%s' and the areas %v, got '%s' and %v", defaultArea, areas, *values.DefaultValue, actualAreas)
+		}
+		return nil
+	}
+}
+
+func testAccTeamAreasConfig(organizationUrl string, defaultArea string, areas string) string {
+	return testAccTeamConfig(organizationUrl) + fmt.Sprintf(`
+resource "azuredevops_area" "test" {
+  name        = "Developers"
+  parent_path = ""
+  project_id  = azuredevops_project.test.id
+}
+
+resource "azuredevops_team_areas" "test" {
+  default_area = %s
+  project_id   = azuredevops_project.test.id
+  team_id      = azuredevops_team.test.id
+
+  areas = [%s
+  ]
+}
+`, defaultArea, areas)
+}
@@ -0,0 +1,230 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/work"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"strings"
+)
+
+var _ resource.Resource = &TeamIterationsResource{}
+var _ resource.ResourceWithImportState = &TeamIterationsResource{}
+
+func NewTeamIterationsResource() resource.Resource {
+	return &TeamIterationsResource{}
+}
+
+type TeamIterationsResource struct {
+	client          *work.Client
+	coreClient      *core.Client
+	workItemsClient *workitems.Client
+}
+
+type TeamIterationsResourceModel struct {
+	Iterations []string `tfsdk:"iterations"`
+	ProjectId  string   `tfsdk:"project_id"`
+	TeamId     string   `tfsdk:"team_id"`
+}
+
+func (r *TeamIterationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_iterations"
+}
+
+func (r *TeamIterationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the iterations a team is subscribed to within an Azure DevOps project, which are the sprints shown to the team. The team is unsubscribed from the iterations missing from the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"iterations": schema.SetAttribute{
+				MarkdownDescription: "The paths of the iterations of the team, e.g. `Release 1/Sprint 1`.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamIterationsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).WorkClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+	r.workItemsClient = req.ProviderData.(*clients.AzureDevOpsClient).WorkItemsClient
+}
+
+func (r *TeamIterationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *TeamIterationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamIterations(ctx, model.ProjectId, model.TeamId, model.Iterations)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team iterations", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamIterationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *TeamIterationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.coreClient.GetTeam(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the team", err.Error())
+		return
+	}
+
+	iterations, err := r.client.GetTeamIterations(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve team iterations", err.Error())
+		return
+	}
+
+	paths := []string{}
+	for _, iteration := range *iterations {
+		paths = append(paths, keepConfiguredPath(model.Iterations, getIterationPath(&iteration)))
+	}
+	model.Iterations = paths
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamIterationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *TeamIterationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamIterations(ctx, model.ProjectId, model.TeamId, model.Iterations)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team iterations", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamIterationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model *TeamIterationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamIterations(ctx, model.ProjectId, model.TeamId, []string{})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete team iterations", err.Error())
+	}
+}
+
+func (r *TeamIterationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/teamId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	team, err := r.coreClient.GetTeam(ctx, projectId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find team '%s'", parts[1]), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &TeamIterationsResourceModel{Iterations: []string{}, ProjectId: projectId, TeamId: team.Id.String()})...)
+}
+
+// Private Methods
+
+// updateTeamIterations subscribes the team to the iterations of paths, and unsubscribes it from the other ones.
+func (r *TeamIterationsResource) updateTeamIterations(ctx context.Context, projectId string, teamId string, paths []string) error {
+	iterations, err := r.client.GetTeamIterations(ctx, projectId, teamId)
+	if err != nil {
+		return err
+	}
+
+	subscribedPaths := map[string]string{}
+	for _, iteration := range *iterations {
+		subscribedPaths[strings.ToLower(getIterationPath(&iteration))] = iteration.Id.String()
+	}
+
+	for _, path := range paths {
+		if _, ok := subscribedPaths[strings.ToLower(path)]; ok {
+			delete(subscribedPaths, strings.ToLower(path))
+			continue
+		}
+
+		node, err := r.workItemsClient.GetIteration(ctx, projectId, path)
+		if err != nil {
+			return fmt.Errorf("unable to find iteration '%s': %s", path, err.Error())
+		}
+
+		_, err = r.client.AddTeamIteration(ctx, projectId, teamId, *node.Identifier)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, iterationId := range subscribedPaths {
+		err = r.client.RemoveTeamIteration(ctx, projectId, teamId, iterationId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
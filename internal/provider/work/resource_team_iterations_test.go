@@ -0,0 +1,91 @@
+package work_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"golang.org/x/exp/slices"
+	"testing"
+)
+
+func TestAccTeamIterationsResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The iterations are planned with the ID of an existing project
+				Config: testAccTeamConfig(server.URL),
+			},
+			{
+				// The paths are matched regardless of their case
+				Config: testAccTeamIterationsConfig(server.URL, `"iteration 1", azuredevops_iteration.test.path`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_iterations.test", "iterations.#", "2"),
+					resource.TestCheckTypeSetElemAttr("azuredevops_team_iterations.test", "iterations.*", "iteration 1"),
+					testAccCheckTeamIterations(server, "Sandbox\\Iteration 1", "Sandbox\\Sprint 1"),
+				),
+			},
+			{
+				Config: testAccTeamIterationsConfig(server.URL, `azuredevops_iteration.test.path`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_iterations.test", "iterations.#", "1"),
+					testAccCheckTeamIterations(server, "Sandbox\\Sprint 1"),
+				),
+			},
+			{
+				ResourceName:                         "azuredevops_team_iterations.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/Developers Team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
+			{
+				// Removing the resource unsubscribes the team from all its iterations
+				Config: testAccTeamConfig(server.URL),
+				Check:  testAccCheckTeamIterations(server),
+			},
+		},
+	})
+}
+
+// testAccCheckTeamIterations checks the paths of the iterations the team is subscribed to.
+func testAccCheckTeamIterations(server *fakeserver.Server, paths ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		team := s.RootModule().Resources["azuredevops_team.test"].Primary
+		iterations, err := acctest.NewClient(server).WorkClient.GetTeamIterations(context.Background(), team.Attributes["project_id"], team.ID)
+		if err != nil {
+			return err
+		}
+
+		var actualPaths []string
+		for _, iteration := range *iterations {
+			actualPaths = append(actualPaths, *iteration.Path)
+		}
+		slices.Sort(actualPaths)
+		if !slices.Equal(actualPaths, paths) {
+			return fmt.Errorf("expected the iterations %v, got %v", paths, actualPaths)
+		}
+		return nil
+	}
+}
+
+func testAccTeamIterationsConfig(organizationUrl string, iterations string) string {
+	return testAccTeamConfig(organizationUrl) + fmt.Sprintf(`
+resource "azuredevops_iteration" "test" {
+  name        = "Sprint 1"
+  parent_path = ""
+  project_id  = azuredevops_project.test.id
+}
+
+resource "azuredevops_team_iterations" "test" {
+  iterations = [%s]
+  project_id = azuredevops_project.test.id
+  team_id    = azuredevops_team.test.id
+}
+`, iterations)
+}
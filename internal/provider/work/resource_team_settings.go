@@ -0,0 +1,314 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/work"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
+	"golang.org/x/exp/maps"
+	"sort"
+	"strings"
+)
+
+var _ resource.Resource = &TeamSettingsResource{}
+var _ resource.ResourceWithImportState = &TeamSettingsResource{}
+
+func NewTeamSettingsResource() resource.Resource {
+	return &TeamSettingsResource{}
+}
+
+type TeamSettingsResource struct {
+	client          *work.Client
+	coreClient      *core.Client
+	workItemsClient *workitems.Client
+}
+
+type TeamSettingsResourceModel struct {
+	BacklogIteration     *string  `tfsdk:"backlog_iteration"`
+	BugsBehavior         *string  `tfsdk:"bugs_behavior"`
+	DefaultIteration     *string  `tfsdk:"default_iteration"`
+	ProjectId            string   `tfsdk:"project_id"`
+	TeamId               string   `tfsdk:"team_id"`
+	VisibleBacklogLevels []string `tfsdk:"visible_backlog_levels"`
+	WorkingDays          []string `tfsdk:"working_days"`
+}
+
+func (r *TeamSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_settings"
+}
+
+func (r *TeamSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the agile settings of a team within an Azure DevOps project. Only the configured settings are managed, and destroying this resource leaves the settings of the team unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"backlog_iteration": schema.StringAttribute{
+				MarkdownDescription: "The path of the iteration under which the iterations of the team are selected, e.g. `Release 1`. An empty path selects the root iteration of the project.",
+				Optional:            true,
+			},
+			"bugs_behavior": schema.StringAttribute{
+				MarkdownDescription: "How bugs are shown on the backlogs and boards of the team. Must be `off`, `asRequirements` or `asTasks`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(work.BugsBehaviorOff, work.BugsBehaviorAsRequirements, work.BugsBehaviorAsTasks),
+				},
+			},
+			"default_iteration": schema.StringAttribute{
+				MarkdownDescription: "The path of the iteration of the new work items created by the team, e.g. `Release 1/Sprint 1`, or `@CurrentIteration` to follow the current iteration.",
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"visible_backlog_levels": schema.SetAttribute{
+				MarkdownDescription: "The reference names of the categories of the backlog levels shown to the team, e.g. `Microsoft.FeatureCategory` and `Microsoft.RequirementCategory`. The other backlog levels are hidden.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"working_days": schema.SetAttribute{
+				MarkdownDescription: "The working days of the team, e.g. `monday`. Must be lowercase names of days.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday")),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*clients.AzureDevOpsClient).WorkClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
+	r.workItemsClient = req.ProviderData.(*clients.AzureDevOpsClient).WorkItemsClient
+}
+
+func (r *TeamSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model *TeamSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamSettings(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model *TeamSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.coreClient.GetTeam(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to retrieve the team", err.Error())
+		return
+	}
+
+	settings, err := r.client.GetTeamSettings(ctx, model.ProjectId, model.TeamId)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve team settings", err.Error())
+		return
+	}
+
+	readTeamSettings(settings, model, false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model *TeamSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateTeamSettings(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *TeamSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The settings of a team cannot be deleted, they are left as they are
+}
+
+func (r *TeamSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/teamId")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	team, err := r.coreClient.GetTeam(ctx, projectId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find team '%s'", parts[1]), err.Error())
+		return
+	}
+
+	settings, err := r.client.GetTeamSettings(ctx, projectId, team.Id.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve team settings", err.Error())
+		return
+	}
+
+	model := &TeamSettingsResourceModel{ProjectId: projectId, TeamId: team.Id.String()}
+	readTeamSettings(settings, model, true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Private Methods
+
+func getIterationPath(iteration *work.TeamSettingsIteration) string {
+	if iteration == nil || iteration.Path == nil {
+		return ""
+	}
+	return getRelativePath(*iteration.Path)
+}
+
+// readTeamSettings copies the settings of a team to the attributes of the model, only the managed ones unless all
+// is true.
+func readTeamSettings(settings *work.TeamSetting, model *TeamSettingsResourceModel, all bool) {
+	if all || model.BacklogIteration != nil {
+		model.BacklogIteration = utils.String(getIterationPath(settings.BacklogIteration))
+	}
+
+	if (all || model.BugsBehavior != nil) && settings.BugsBehavior != nil {
+		model.BugsBehavior = settings.BugsBehavior
+	}
+
+	if all || model.DefaultIteration != nil {
+		if settings.DefaultIterationMacro != nil && *settings.DefaultIterationMacro != "" {
+			if model.DefaultIteration == nil || !strings.EqualFold(*model.DefaultIteration, defaultIterationMacro) {
+				model.DefaultIteration = utils.String(defaultIterationMacro)
+			}
+		} else {
+			model.DefaultIteration = utils.String(getIterationPath(settings.DefaultIteration))
+		}
+	}
+
+	if (all || model.VisibleBacklogLevels != nil) && settings.BacklogVisibilities != nil {
+		visibleBacklogLevels := []string{}
+		for category, visible := range *settings.BacklogVisibilities {
+			if visible {
+				visibleBacklogLevels = append(visibleBacklogLevels, category)
+			}
+		}
+		sort.Strings(visibleBacklogLevels)
+		model.VisibleBacklogLevels = visibleBacklogLevels
+	}
+
+	if (all || model.WorkingDays != nil) && settings.WorkingDays != nil {
+		model.WorkingDays = append([]string{}, *settings.WorkingDays...)
+	}
+}
+
+func (r *TeamSettingsResource) updateTeamSettings(ctx context.Context, model *TeamSettingsResourceModel) error {
+	patch := &work.TeamSettingsPatch{
+		BugsBehavior: model.BugsBehavior,
+	}
+
+	if model.BacklogIteration != nil {
+		iteration, err := r.workItemsClient.GetIteration(ctx, model.ProjectId, *model.BacklogIteration)
+		if err != nil {
+			return fmt.Errorf("unable to find backlog iteration '%s': %s", *model.BacklogIteration, err.Error())
+		}
+		patch.BacklogIteration = iteration.Identifier
+	}
+
+	if model.DefaultIteration != nil {
+		if strings.EqualFold(*model.DefaultIteration, defaultIterationMacro) {
+			patch.DefaultIterationMacro = utils.String(work.DefaultIterationMacroCurrentIteration)
+		} else {
+			iteration, err := r.workItemsClient.GetIteration(ctx, model.ProjectId, *model.DefaultIteration)
+			if err != nil {
+				return fmt.Errorf("unable to find default iteration '%s': %s", *model.DefaultIteration, err.Error())
+			}
+			patch.DefaultIteration = iteration.Identifier
+		}
+	}
+
+	if model.VisibleBacklogLevels != nil {
+		// The backlog levels missing from the configuration are hidden, the categories are read from the team
+		settings, err := r.client.GetTeamSettings(ctx, model.ProjectId, model.TeamId)
+		if err != nil {
+			return err
+		}
+
+		backlogVisibilities := map[string]bool{}
+		if settings.BacklogVisibilities != nil {
+			for _, category := range maps.Keys(*settings.BacklogVisibilities) {
+				backlogVisibilities[category] = false
+			}
+		}
+		for _, category := range model.VisibleBacklogLevels {
+			backlogVisibilities[category] = true
+		}
+		patch.BacklogVisibilities = &backlogVisibilities
+	}
+
+	if model.WorkingDays != nil {
+		workingDays := append([]string{}, model.WorkingDays...)
+		patch.WorkingDays = &workingDays
+	}
+
+	_, err := r.client.UpdateTeamSettings(ctx, model.ProjectId, model.TeamId, patch)
+	return err
+}
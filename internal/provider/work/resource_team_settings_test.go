@@ -0,0 +1,108 @@
+package work_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/acctest/fakeserver"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/work"
+	"testing"
+)
+
+func TestAccTeamSettingsResource(t *testing.T) {
+	server := acctest.NewServer(t, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamSettingsConfig(server.URL, "Iteration 1", `["monday", "friday"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_settings.test", "backlog_iteration", ""),
+					resource.TestCheckResourceAttr("azuredevops_team_settings.test", "default_iteration", "Iteration 1"),
+					resource.TestCheckResourceAttr("azuredevops_team_settings.test", "visible_backlog_levels.#", "2"),
+					resource.TestCheckResourceAttr("azuredevops_team_settings.test", "working_days.#", "2"),
+					testAccCheckTeamSettings(server, func(settings *work.TeamSetting) error {
+						if *settings.BugsBehavior != work.BugsBehaviorAsRequirements {
+							return fmt.Errorf("expected the bugs behavior '%s', got '%s'", work.BugsBehaviorAsRequirements, *settings.BugsBehavior)
+						}
+						// The backlog levels missing from the configuration are hidden
+						if visibilities := *settings.BacklogVisibilities; !visibilities["Microsoft.EpicCategory"] || visibilities["Microsoft.FeatureCategory"] {
+							return fmt.Errorf("unexpected backlog visibilities %v", visibilities)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccTeamSettingsConfig(server.URL, "@CurrentIteration", `["monday"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_team_settings.test", "default_iteration", "@CurrentIteration"),
+					resource.TestCheckResourceAttr("azuredevops_team_settings.test", "working_days.#", "1"),
+					testAccCheckTeamSettings(server, func(settings *work.TeamSetting) error {
+						if settings.DefaultIteration != nil || settings.DefaultIterationMacro == nil {
+							return fmt.Errorf("expected the default iteration macro, got the iteration %v", settings.DefaultIteration)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:                         "azuredevops_team_settings.test",
+				ImportState:                          true,
+				ImportStateId:                        "Sandbox/Developers Team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
+		},
+	})
+}
+
+// testAccCheckTeamSettings checks the settings of the team with a function returning an error when they are not the
+// expected ones.
+func testAccCheckTeamSettings(server *fakeserver.Server, check func(settings *work.TeamSetting) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		team := s.RootModule().Resources["azuredevops_team.test"].Primary
+		settings, err := acctest.NewClient(server).WorkClient.GetTeamSettings(context.Background(), team.Attributes["project_id"], team.ID)
+		if err != nil {
+			return err
+		}
+		return check(settings)
+	}
+}
+
+func testAccTeamConfig(organizationUrl string) string {
+	return acctest.ProviderConfig(organizationUrl) + `
+data "azuredevops_process" "agile" {
+  name = "Agile"
+}
+
+resource "azuredevops_project" "test" {
+  name                = "Sandbox"
+  process_template_id = data.azuredevops_process.agile.id
+  version_control     = "Git"
+  visibility          = "private"
+}
+
+resource "azuredevops_team" "test" {
+  name       = "Developers Team"
+  project_id = azuredevops_project.test.id
+}
+`
+}
+
+func testAccTeamSettingsConfig(organizationUrl string, defaultIteration string, workingDays string) string {
+	return testAccTeamConfig(organizationUrl) + fmt.Sprintf(`
+resource "azuredevops_team_settings" "test" {
+  backlog_iteration      = ""
+  bugs_behavior          = "asRequirements"
+  default_iteration      = %q
+  project_id             = azuredevops_project.test.id
+  team_id                = azuredevops_team.test.id
+  visible_backlog_levels = ["Microsoft.EpicCategory", "Microsoft.RequirementCategory"]
+  working_days           = %s
+}
+`, defaultIteration, workingDays)
+}
@@ -29,6 +29,13 @@ func getAreaOrIterationPath(node *workitems.WorkItemClassificationNode) string {
 	return finalPath
 }
 
+func getAreaOrIterationParentPath(path string) string {
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
 func planAreaOrIterationPath(path string, name string, isMove bool) string {
 	if isMove {
 		return strings.TrimPrefix(strings.Join([]string{path, name}, "/"), "/")
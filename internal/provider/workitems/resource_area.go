@@ -2,6 +2,7 @@ package workitems
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
@@ -17,6 +19,7 @@ import (
 )
 
 var _ resource.Resource = &AreaResource{}
+var _ resource.ResourceWithImportState = &AreaResource{}
 var _ resource.ResourceWithModifyPlan = &AreaResource{}
 
 func NewAreaResource() resource.Resource {
@@ -24,7 +27,8 @@ func NewAreaResource() resource.Resource {
 }
 
 type AreaResource struct {
-	client *workitems.Client
+	client     *workitems.Client
+	coreClient *core.Client
 }
 
 type AreaResourceModel struct {
@@ -85,6 +89,7 @@ func (r *AreaResource) Configure(_ context.Context, req resource.ConfigureReques
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).WorkItemsClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *AreaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -174,6 +179,35 @@ func (r *AreaResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+func (r *AreaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/path")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	area, err := r.client.GetArea(ctx, projectId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Area not found", err.Error())
+		return
+	}
+
+	path := getAreaOrIterationPath(area)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &AreaResourceModel{
+		Id:         types.Int64Value(int64(*area.Id)),
+		Name:       *area.Name,
+		ParentPath: getAreaOrIterationParentPath(path),
+		Path:       types.StringValue(path),
+		ProjectId:  projectId,
+	})...)
+}
+
 func (r *AreaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var currentModel *AreaResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &currentModel)...)
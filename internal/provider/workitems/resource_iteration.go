@@ -2,6 +2,7 @@ package workitems
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
@@ -17,6 +19,7 @@ import (
 )
 
 var _ resource.Resource = &IterationResource{}
+var _ resource.ResourceWithImportState = &IterationResource{}
 var _ resource.ResourceWithModifyPlan = &IterationResource{}
 
 func NewIterationResource() resource.Resource {
@@ -24,7 +27,8 @@ func NewIterationResource() resource.Resource {
 }
 
 type IterationResource struct {
-	client *workitems.Client
+	client     *workitems.Client
+	coreClient *core.Client
 }
 
 type IterationResourceModel struct {
@@ -101,6 +105,7 @@ func (r *IterationResource) Configure(_ context.Context, req resource.ConfigureR
 	}
 
 	r.client = req.ProviderData.(*clients.AzureDevOpsClient).WorkItemsClient
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 }
 
 func (r *IterationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -190,6 +195,35 @@ func (r *IterationResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+func (r *IterationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/path")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	iteration, err := r.client.GetIteration(ctx, projectId, parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Iteration not found", err.Error())
+		return
+	}
+
+	path := getAreaOrIterationPath(iteration)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &IterationResourceModel{
+		Id:         types.Int64Value(int64(*iteration.Id)),
+		Name:       *iteration.Name,
+		ParentPath: getAreaOrIterationParentPath(path),
+		Path:       types.StringValue(path),
+		ProjectId:  projectId,
+	})...)
+}
+
 func (r *IterationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var currentModel *IterationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &currentModel)...)
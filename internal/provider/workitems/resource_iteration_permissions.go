@@ -11,21 +11,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/core"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/graph"
 	clientSecurity "github.com/scordonnier/terraform-provider-azuredevops/internal/clients/security"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/clients/workitems"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/provider/security"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/utils"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/validators"
 	"strings"
 )
 
 var _ resource.Resource = &IterationPermissionsResource{}
+var _ resource.ResourceWithImportState = &IterationPermissionsResource{}
 
 func NewIterationPermissionsResource() resource.Resource {
 	return &IterationPermissionsResource{}
 }
 
 type IterationPermissionsResource struct {
+	coreClient      *core.Client
 	graphClient     *graph.Client
 	securityClient  *clientSecurity.Client
 	workItemsClient *workitems.Client
@@ -122,6 +126,7 @@ func (r *IterationPermissionsResource) Configure(_ context.Context, req resource
 		return
 	}
 
+	r.coreClient = req.ProviderData.(*clients.AzureDevOpsClient).CoreClient
 	r.graphClient = req.ProviderData.(*clients.AzureDevOpsClient).GraphClient
 	r.securityClient = req.ProviderData.(*clients.AzureDevOpsClient).SecurityClient
 	r.workItemsClient = req.ProviderData.(*clients.AzureDevOpsClient).WorkItemsClient
@@ -225,6 +230,42 @@ func (r *IterationPermissionsResource) Delete(ctx context.Context, req resource.
 	}
 }
 
+func (r *IterationPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.ParseImportId(req.ID, "projectId/principalName/path", "projectId/principalName")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import identifier", err.Error())
+		return
+	}
+
+	projectId, err := r.coreClient.GetProjectId(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find project '%s'", parts[0]), err.Error())
+		return
+	}
+
+	model := &IterationPermissionsResourceModel{ProjectId: projectId}
+	if len(parts) == 3 {
+		model.Path = parts[2]
+	}
+
+	token, err := r.getToken(ctx, projectId, model.Path)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve token", err.Error())
+		return
+	}
+
+	permissions, err := security.ImportPrincipalPermissions(ctx, clientSecurity.NamespaceIdIteration, token, parts[1], r.securityClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to retrieve access control lists", err.Error())
+		return
+	}
+
+	model.PrincipalDescriptor = types.StringValue(permissions.PrincipalDescriptor)
+	model.PrincipalName = permissions.PrincipalName
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
 // Private Methods
 
 func (r *IterationPermissionsResource) getPermissions(model *IterationPermissionsResourceModel) *security.PrincipalPermissions {
@@ -1,6 +1,16 @@
 package utils
 
-import "golang.org/x/exp/slices"
+import (
+	"golang.org/x/exp/slices"
+	"strings"
+)
+
+// ContainsFold returns whether a contains s, regardless of its case.
+func ContainsFold(a []string, s string) bool {
+	return slices.ContainsFunc(a, func(item string) bool {
+		return strings.EqualFold(item, s)
+	})
+}
 
 func Difference(a, b *[]string) *[]string {
 	var c []string
@@ -12,3 +22,15 @@ func Difference(a, b *[]string) *[]string {
 	}
 	return &c
 }
+
+// DifferenceFold returns the strings of a missing from b, regardless of their case.
+func DifferenceFold(a, b *[]string) *[]string {
+	var c []string
+	for _, s := range *a {
+		if ContainsFold(*b, s) {
+			continue
+		}
+		c = append(c, s)
+	}
+	return &c
+}
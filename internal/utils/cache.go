@@ -1,7 +1,115 @@
 package utils
 
-import "strings"
+import (
+	"context"
+	"github.com/patrickmn/go-cache"
+	"github.com/scordonnier/terraform-provider-azuredevops/internal/logger"
+	"strings"
+	"sync"
+	"time"
+)
+
+type CacheKind string
+
+const (
+	CacheKindGroupDescriptor    CacheKind = "GroupDescriptor"
+	CacheKindIdentity           CacheKind = "Identity"
+	CacheKindIdentityPicker     CacheKind = "IdentityPicker"
+	CacheKindProjectDescriptor  CacheKind = "ProjectDescriptor"
+	CacheKindSecurityNamespaces CacheKind = "SecurityNamespaces"
+
+	cacheKeySeparator = "***"
+)
+
+// Time to live of the entries of each kind. Groups and identities can be renamed or deleted outside of Terraform,
+// the descriptors of projects and the security namespaces never change.
+var cacheTTLs = map[CacheKind]time.Duration{
+	CacheKindGroupDescriptor:    5 * time.Minute,
+	CacheKindIdentity:           10 * time.Minute,
+	CacheKindIdentityPicker:     5 * time.Minute,
+	CacheKindProjectDescriptor:  cache.NoExpiration,
+	CacheKindSecurityNamespaces: cache.NoExpiration,
+}
+
+// Cache is shared by the clients of an organization, so that an object written by one client is never read stale by
+// another one. The clients invalidate the entries of the objects they create, update or delete.
+type Cache struct {
+	cache  *cache.Cache
+	hits   map[CacheKind]int
+	misses map[CacheKind]int
+	mutex  sync.Mutex
+}
+
+type cacheStats struct {
+	hits   int
+	misses int
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		cache:  cache.New(cache.NoExpiration, 10*time.Minute),
+		hits:   map[CacheKind]int{},
+		misses: map[CacheKind]int{},
+	}
+}
 
 func GetCacheKey(params ...string) string {
-	return strings.Join(params, "***")
+	return strings.Join(params, cacheKeySeparator)
+}
+
+// Get returns the entry of an object, and logs the hit or the miss along with the statistics of the kind.
+func (c *Cache) Get(ctx context.Context, kind CacheKind, keys ...string) (any, bool) {
+	value, ok := c.cache.Get(getCacheKindKey(kind, keys...))
+	stats := c.record(kind, ok)
+	logger.Debug(ctx, IfThenElse[string](ok, "Cache hit", "Cache miss"), map[string]any{
+		"cache_hits":   stats.hits,
+		"cache_key":    GetCacheKey(keys...),
+		"cache_kind":   string(kind),
+		"cache_misses": stats.misses,
+	})
+	return value, ok
+}
+
+// Invalidate removes all the entries of some kinds, for the changes whose impacted entries cannot be known, e.g. the
+// rename of a group cached under its previous name.
+func (c *Cache) Invalidate(ctx context.Context, kinds ...CacheKind) {
+	for key := range c.cache.Items() {
+		for _, kind := range kinds {
+			if strings.HasPrefix(key, string(kind)+cacheKeySeparator) {
+				c.cache.Delete(key)
+			}
+		}
+	}
+
+	for _, kind := range kinds {
+		logger.Debug(ctx, "Cache entries invalidated", map[string]any{
+			"cache_kind": string(kind),
+		})
+	}
+}
+
+func (c *Cache) Set(kind CacheKind, value any, keys ...string) {
+	ttl, ok := cacheTTLs[kind]
+	if !ok {
+		ttl = cache.DefaultExpiration
+	}
+	c.cache.Set(getCacheKindKey(kind, keys...), value, ttl)
+}
+
+// Private Methods
+
+func getCacheKindKey(kind CacheKind, keys ...string) string {
+	return GetCacheKey(append([]string{string(kind)}, keys...)...)
+}
+
+func (c *Cache) record(kind CacheKind, hit bool) cacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if hit {
+		c.hits[kind]++
+	} else {
+		c.misses[kind]++
+	}
+	return cacheStats{hits: c.hits[kind], misses: c.misses[kind]}
 }
@@ -1,48 +1,34 @@
 package utils
 
 import (
+	"errors"
 	"github.com/scordonnier/terraform-provider-azuredevops/internal/networking"
-	"net/http"
 	"strings"
 )
 
-func ResponseWasNotFound(err error) bool {
-	statusNotFound := ResponseWasStatusCode(err, http.StatusNotFound)
-	if statusNotFound {
-		return statusNotFound
-	}
+// ResponseWasConflict returns whether an object with the same name already exists.
+func ResponseWasConflict(err error) bool {
+	return errors.Is(err, networking.ErrConflict)
+}
 
-	// Some APIs return 400 BadRequest with the VS800075 error message if
-	// DevOps Project doesn't exist. If parent project doesn't exist, all
-	// child resources are considered "doesn't exist".
-	statusBadRequest := ResponseWasStatusCode(err, http.StatusBadRequest)
-	if statusBadRequest {
-		return ResponseContainsStatusMessage(err, "VS800075")
-	}
-	return false
+// ResponseWasNotFound returns whether the object does not exist, including the objects of a project which does not
+// exist since all child resources are then considered as missing.
+func ResponseWasNotFound(err error) bool {
+	return errors.Is(err, networking.ErrNotFound)
 }
 
 func ResponseWasStatusCode(err error, statusCode int) bool {
-	if err == nil {
-		return false
-	}
-	if wrapperErr, ok := err.(networking.WrappedError); ok {
-		if wrapperErr.StatusCode != nil && *wrapperErr.StatusCode == statusCode {
-			return true
-		}
+	var wrappedError *networking.WrappedError
+	if errors.As(err, &wrappedError) {
+		return wrappedError.StatusCode != nil && *wrappedError.StatusCode == statusCode
 	}
 	return false
 }
 
 func ResponseContainsStatusMessage(err error, statusMessage string) bool {
-	if err == nil {
-		return false
-	}
-	if wrapperErr, ok := err.(networking.WrappedError); ok {
-		if wrapperErr.Message == nil {
-			return false
-		}
-		return strings.Contains(*wrapperErr.Message, statusMessage)
+	var wrappedError *networking.WrappedError
+	if errors.As(err, &wrappedError) {
+		return wrappedError.Message != nil && strings.Contains(*wrappedError.Message, statusMessage)
 	}
 	return false
 }
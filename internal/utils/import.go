@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseImportId splits an import identifier into the slash separated parts of the first matching format. The last
+// part may contain slashes itself, which allows importing resources identified by a path (e.g. areas). Formats
+// must be ordered from the longest to the shortest.
+func ParseImportId(id string, formats ...string) ([]string, error) {
+	for _, format := range formats {
+		count := len(strings.Split(format, "/"))
+		parts := strings.SplitN(id, "/", count)
+		if len(parts) != count {
+			continue
+		}
+
+		valid := true
+		for _, part := range parts {
+			if strings.TrimSpace(part) == "" {
+				valid = false
+				break
+			}
+		}
+		if valid {
+			return parts, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected import identifier '%s', expected '%s'", id, strings.Join(formats, "' or '"))
+}
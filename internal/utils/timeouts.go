@@ -0,0 +1,11 @@
+package utils
+
+import "time"
+
+// Default timeouts of the resources waiting on long-running operations, overridden by their timeouts block.
+const (
+	DefaultCreateTimeout = 10 * time.Minute
+	DefaultDeleteTimeout = 10 * time.Minute
+	DefaultReadTimeout   = 5 * time.Minute
+	DefaultUpdateTimeout = 10 * time.Minute
+)
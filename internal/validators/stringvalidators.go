@@ -10,6 +10,10 @@ func AllowDenyNotset() validator.String {
 	return stringvalidator.OneOfCaseInsensitive("notset", "allow", "deny")
 }
 
+func ApiVersion() validator.String {
+	return stringvalidator.RegexMatches(regexp.MustCompile("^[0-9]+\\.[0-9]+(-preview(\\.[0-9]+)?)?$"), "must be a valid API version (eg. 7.0 or 7.1-preview.1)")
+}
+
 func DateTime() validator.String {
 	return stringvalidator.RegexMatches(regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}Z$"), "must not be valid date (eg. 2000-12-25T00:00:00Z)")
 }